@@ -0,0 +1,61 @@
+package wv2
+
+import (
+	"github.com/b1naryth1ef/wv2/pkg/shortcut"
+	"github.com/b1naryth1ef/wv2/winc"
+	"github.com/b1naryth1ef/wv2/winc/w32"
+)
+
+// Shortcuts returns the keyboard shortcuts of the window. They are dispatched while the WebView or one of the
+// native controls of the window has the keyboard focus.
+func (w *Window) Shortcuts() *shortcut.Registry {
+	return &w.shortcuts
+}
+
+// WincShortcut converts s for native menus, which don't support the Win modifier.
+func WincShortcut(s shortcut.Shortcut) (winc.Shortcut, bool) {
+	if s.Modifiers&shortcut.ModWin != 0 {
+		return winc.Shortcut{}, false
+	}
+	return winc.Shortcut{Modifiers: winc.Modifiers(s.Modifiers), Key: winc.Key(s.Key)}, true
+}
+
+// ShortcutFromWinc converts a shortcut of a native menu.
+func ShortcutFromWinc(s winc.Shortcut) shortcut.Shortcut {
+	return shortcut.Shortcut{Modifiers: shortcut.Modifiers(s.Modifiers), Key: uint16(s.Key)}
+}
+
+// shortcutModifiersDown returns the modifiers held while the current key message has been generated.
+func shortcutModifiersDown() shortcut.Modifiers {
+	m := shortcut.Modifiers(winc.ModifiersDown())
+	if w32.GetKeyState(w32.VK_LWIN)>>15 != 0 || w32.GetKeyState(w32.VK_RWIN)>>15 != 0 {
+		m |= shortcut.ModWin
+	}
+	return m
+}
+
+// acceleratorKeyPressed dispatches the keys pressed while the WebView has the focus, the page doesn't receive keys
+// which trigger a shortcut.
+func (w *Window) acceleratorKeyPressed(key uint) bool {
+	handler := w.shortcuts.Match(shortcut.Shortcut{Modifiers: shortcutModifiersDown(), Key: uint16(key)}, true)
+	if handler == nil {
+		return false
+	}
+	handler()
+	return true
+}
+
+// PreTranslateMessage dispatches the keys pressed while the window or one of its native controls has the focus.
+func (w *Window) PreTranslateMessage(msg *w32.MSG) bool {
+	if msg.Message == w32.WM_KEYDOWN || msg.Message == w32.WM_SYSKEYDOWN {
+		// Ignore the repeats of held keys
+		if msg.LParam>>30&1 == 0 {
+			pressed := shortcut.Shortcut{Modifiers: shortcutModifiersDown(), Key: uint16(msg.WParam)}
+			if handler := w.shortcuts.Match(pressed, false); handler != nil {
+				handler()
+				return true
+			}
+		}
+	}
+	return w.Form.PreTranslateMessage(msg)
+}
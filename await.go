@@ -0,0 +1,31 @@
+package wv2
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrCalledOnUIThread is returned by blocking calls that would deadlock because they wait for a completion which is
+// delivered on the UI thread. Use the callback based methods of the underlying edge.Chromium there instead.
+var ErrCalledOnUIThread = errors.New("wv2: blocking call on the UI thread")
+
+// await runs start on the UI thread and waits until it called done or ctx is done.
+func (w *Window) await(ctx context.Context, start func(done func(err error)) error) error {
+	if !w.InvokeRequired() {
+		return ErrCalledOnUIThread
+	}
+
+	result := make(chan error, 1)
+	w.Invoke(func() {
+		if err := start(func(err error) { result <- err }); err != nil {
+			result <- err
+		}
+	})
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,173 @@
+package wv2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// errBindingNotAllowed is returned to calls of bindings from frames which are not trusted.
+var errBindingNotAllowed = errors.New("wv2: bindings are not available in this frame")
+
+// bindingsScript sets up window.wv2.bindings, calls are posted to the window and resolved by wv2.resolve.
+const bindingsScript = `(() => {
+	const wv2 = window.wv2;
+	const pending = new Map();
+	let nextID = 0;
+	wv2.bindings = {};
+	wv2.bind = (name) => {
+		wv2.bindings[name] = (...args) => new Promise((resolve, reject) => {
+			const id = ++nextID;
+			pending.set(id, {resolve, reject});
+			wv2.post("call", {id, name, args});
+		});
+	};
+	wv2.resolve = (id, result, error) => {
+		const call = pending.get(id);
+		if (!call) {
+			return;
+		}
+		pending.delete(id);
+		error === null ? call.resolve(result) : call.reject(new Error(error));
+	};
+})();`
+
+type bindingCall struct {
+	ID   uint64            `json:"id"`
+	Name string            `json:"name"`
+	Args []json.RawMessage `json:"args"`
+}
+
+// Bind exposes fn to the page as window.wv2.bindings[name], calling it returns a Promise for the result of fn.
+//
+// fn may take any number of arguments which are unmarshalled from the JSON encoded arguments of the call and
+// return nothing, a value, an error or a value and an error. It runs on its own goroutine. Bindings can be called
+// from the top level document and from frames of WindowOpts.TrustedFrameOrigins, calls from other frames are
+// rejected.
+func (w *Window) Bind(name string, fn interface{}) error {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return fmt.Errorf("wv2: binding %s is not a func", name)
+	}
+	t := v.Type()
+	if t.IsVariadic() {
+		return fmt.Errorf("wv2: binding %s must not be variadic", name)
+	}
+	switch {
+	case t.NumOut() > 2:
+		return fmt.Errorf("wv2: binding %s returns more than two values", name)
+	case t.NumOut() == 2 && t.Out(1) != errorType:
+		return fmt.Errorf("wv2: second result of binding %s must be an error", name)
+	}
+
+	w.bindings[name] = v
+
+	script := fmt.Sprintf("window.wv2.bind(%q);", name)
+	w.chromium.Init(script)
+	w.chromium.Eval(script)
+	for _, f := range w.frames {
+		if f.Trusted() {
+			f.ExecuteScript(script)
+		}
+	}
+	return nil
+}
+
+func (w *Window) initBindings() {
+	w.bindings = make(map[string]reflect.Value)
+	w.chromium.Init(bindingsScript)
+	w.handleMessage("call", func(frame *Frame, data json.RawMessage) {
+		var call bindingCall
+		if err := json.Unmarshal(data, &call); err != nil {
+			log.Printf("Invalid binding call: %v", err)
+			return
+		}
+
+		fn, found := w.bindings[call.Name]
+		if !found {
+			w.resolveCall(frame, call.ID, nil, fmt.Errorf("wv2: unknown binding %s", call.Name))
+			return
+		}
+
+		go func() {
+			result, err := callBinding(fn, call.Args)
+			w.Invoke(func() {
+				w.resolveCall(frame, call.ID, result, err)
+			})
+		}()
+	})
+}
+
+// rejectCall answers a call from a frame which isn't allowed to use bindings.
+func (w *Window) rejectCall(frame *Frame, data json.RawMessage) {
+	var call bindingCall
+	if err := json.Unmarshal(data, &call); err != nil {
+		return
+	}
+	w.resolveCall(frame, call.ID, nil, errBindingNotAllowed)
+}
+
+func (w *Window) resolveCall(frame *Frame, id uint64, result interface{}, err error) {
+	if frame != nil && !frame.Trusted() {
+		// The frame navigated to an untrusted document while the binding was running.
+		result, err = nil, errBindingNotAllowed
+	}
+
+	resultJSON, marshalErr := json.Marshal(result)
+	if marshalErr != nil && err == nil {
+		err = marshalErr
+	}
+	errorJSON := []byte("null")
+	if err != nil {
+		resultJSON = []byte("null")
+		errorJSON, _ = json.Marshal(err.Error())
+	}
+
+	script := fmt.Sprintf("window.wv2.resolve(%d, %s, %s);", id, resultJSON, errorJSON)
+	if frame == nil {
+		w.chromium.Eval(script)
+		return
+	}
+	if !frame.Destroyed() {
+		frame.ExecuteScript(script)
+	}
+}
+
+func callBinding(fn reflect.Value, args []json.RawMessage) (result interface{}, err error) {
+	t := fn.Type()
+	if len(args) != t.NumIn() {
+		return nil, fmt.Errorf("wv2: expected %d arguments but got %d", t.NumIn(), len(args))
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		v := reflect.New(t.In(i))
+		if err := json.Unmarshal(arg, v.Interface()); err != nil {
+			return nil, fmt.Errorf("wv2: invalid argument %d: %w", i, err)
+		}
+		in[i] = v.Elem()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("wv2: binding panicked: %v", r)
+		}
+	}()
+
+	out := fn.Call(in)
+	switch {
+	case len(out) == 0:
+		return nil, nil
+	case len(out) == 2:
+		err, _ = out[1].Interface().(error)
+		return out[0].Interface(), err
+	case t.Out(0) == errorType:
+		err, _ = out[0].Interface().(error)
+		return nil, err
+	}
+	return out[0].Interface(), nil
+}
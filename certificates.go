@@ -0,0 +1,89 @@
+package wv2
+
+import (
+	"context"
+	"crypto/x509"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/pkg/pinning"
+	"github.com/b1naryth1ef/wv2/winc"
+)
+
+type CertificateErrorAction int
+
+const (
+	// CertificateErrorDefault shows the error page of the webview.
+	CertificateErrorDefault CertificateErrorAction = edge.COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION_DEFAULT
+	// CertificateErrorAlwaysAllow continues the request and trusts the certificate for the host until the actions
+	// are cleared with ClearCertificateErrorActions.
+	CertificateErrorAlwaysAllow CertificateErrorAction = edge.COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION_ALWAYS_ALLOW
+	// CertificateErrorCancel cancels the request.
+	CertificateErrorCancel CertificateErrorAction = edge.COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION_CANCEL
+)
+
+// CertificateErrorEventData is passed to OnCertificateError handlers. Chain starts with the certificate of the
+// server followed by its issuers.
+//
+// If WindowOpts.CertificatePins has pins for the host, Action is preset to CertificateErrorAlwaysAllow if the
+// chain matches them and to CertificateErrorCancel otherwise, PinError holds the result of the verification.
+// Handlers may change Action.
+type CertificateErrorEventData struct {
+	URI         string
+	ErrorStatus edge.COREWEBVIEW2_WEB_ERROR_STATUS
+	Chain       []*x509.Certificate
+	PinError    error
+
+	Action CertificateErrorAction
+}
+
+// OnCertificateError fires when the certificate of a server couldn't be verified, the event data is a
+// *CertificateErrorEventData.
+func (w *Window) OnCertificateError() *winc.EventManager {
+	return &w.onCertificateError
+}
+
+// ClearCertificateErrorActions forgets all certificates which have been allowed with CertificateErrorAlwaysAllow.
+func (w *Window) ClearCertificateErrorActions(ctx context.Context) error {
+	return w.await(ctx, func(done func(error)) error {
+		return w.chromium.ClearServerCertificateErrorActions(done)
+	})
+}
+
+func (w *Window) serverCertificateErrorDetected(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ServerCertificateErrorDetectedEventArgs) {
+	data := &CertificateErrorEventData{Action: CertificateErrorDefault, PinError: pinning.ErrHostNotPinned}
+	data.URI, _ = args.GetRequestUri()
+	data.ErrorStatus, _ = args.GetErrorStatus()
+
+	certificate, err := args.GetServerCertificate()
+	if err != nil {
+		log.Printf("GetServerCertificate failed: %v", err)
+		return
+	}
+	data.Chain, err = certificate.X509Chain()
+	certificate.Release()
+	if err != nil {
+		log.Printf("Reading the certificate chain failed: %v", err)
+	}
+
+	if w.opts.CertificatePins != nil {
+		if u, err := url.Parse(data.URI); err == nil {
+			data.PinError = w.opts.CertificatePins.Verify(u.Hostname(), data.Chain, time.Now())
+		}
+		switch data.PinError {
+		case nil:
+			data.Action = CertificateErrorAlwaysAllow
+		case pinning.ErrHostNotPinned:
+		default:
+			data.Action = CertificateErrorCancel
+		}
+	}
+
+	w.onCertificateError.Fire(winc.NewEvent(w, data))
+
+	if data.Action != CertificateErrorDefault {
+		args.PutAction(edge.COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION(data.Action))
+	}
+}
@@ -0,0 +1,194 @@
+package wv2
+
+import (
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/hex"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/winc"
+)
+
+// ClientCertificate is a certificate which can be used to authenticate at a server requesting mutual TLS.
+type ClientCertificate struct {
+	Subject     string
+	Issuer      string
+	DisplayName string
+	// Thumbprint is the upper case hex encoded SHA-1 hash of the certificate, as shown by the Windows certificate
+	// manager.
+	Thumbprint string
+	NotBefore  time.Time
+	NotAfter   time.Time
+	Kind       edge.COREWEBVIEW2_CLIENT_CERTIFICATE_KIND
+
+	// Certificate is nil if the certificate couldn't be parsed.
+	Certificate *x509.Certificate
+
+	certificate *edge.ICoreWebView2ClientCertificate
+}
+
+// ClientCertificateEventData is passed to OnClientCertificateRequested handlers. Certificates are the candidates
+// which are trusted by the server.
+//
+// Handlers set Selected to one of the candidates or set Cancel to abort the request, otherwise the default
+// certificate picker is shown. Selected is preset to the candidate matching WindowOpts.ClientCertificateThumbprint.
+// To show a custom chooser a handler calls Defer and, after the user picked a certificate, Complete on the UI
+// thread.
+type ClientCertificateEventData struct {
+	Host               string
+	Port               int
+	IsProxy            bool
+	AllowedAuthorities []string
+	Certificates       []*ClientCertificate
+
+	Selected *ClientCertificate
+	Cancel   bool
+
+	args     *edge.ICoreWebView2ClientCertificateRequestedEventArgs
+	deferral *edge.ICoreWebView2Deferral
+	done     bool
+}
+
+// OnClientCertificateRequested fires when a server asks for a client certificate, the event data is a
+// *ClientCertificateEventData.
+func (w *Window) OnClientCertificateRequested() *winc.EventManager {
+	return &w.onClientCertificateRequested
+}
+
+// Defer postpones applying Selected and Cancel until Complete is called.
+func (d *ClientCertificateEventData) Defer() error {
+	if d.deferral != nil || d.done {
+		return nil
+	}
+
+	deferral, err := d.args.GetDeferral()
+	if err != nil {
+		return err
+	}
+	d.args.AddRef()
+	d.deferral = deferral
+	return nil
+}
+
+// Complete applies Selected and Cancel. It must be called on the UI thread after Defer and is called automatically
+// otherwise.
+func (d *ClientCertificateEventData) Complete() error {
+	if d.done {
+		return nil
+	}
+	d.done = true
+
+	var err error
+	switch {
+	case d.Cancel:
+		err = d.args.PutCancel(true)
+	case d.Selected != nil:
+		if err = d.args.PutSelectedCertificate(d.Selected.certificate); err == nil {
+			err = d.args.PutHandled(true)
+		}
+	}
+
+	d.release()
+	if d.deferral != nil {
+		defer d.args.Release()
+		defer d.deferral.Release()
+		if completeErr := d.deferral.Complete(); err == nil {
+			err = completeErr
+		}
+	}
+	return err
+}
+
+func (d *ClientCertificateEventData) release() {
+	for _, c := range d.Certificates {
+		c.certificate.Release()
+	}
+}
+
+func (w *Window) clientCertificateRequested(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ClientCertificateRequestedEventArgs) {
+	data := &ClientCertificateEventData{args: args}
+	data.Host, _ = args.GetHost()
+	port, _ := args.GetPort()
+	data.Port = int(port)
+	data.IsProxy, _ = args.GetIsProxy()
+
+	if authorities, err := args.GetAllowedCertificateAuthorities(); err == nil {
+		data.AllowedAuthorities, _ = authorities.Strings()
+		authorities.Release()
+	}
+
+	certificates, err := args.GetMutuallyTrustedCertificates()
+	if err != nil {
+		log.Printf("GetMutuallyTrustedCertificates failed: %v", err)
+		return
+	}
+	count, _ := certificates.GetCount()
+	for i := uint32(0); i < count; i++ {
+		certificate, err := certificates.GetValueAtIndex(i)
+		if err != nil {
+			log.Printf("GetValueAtIndex failed: %v", err)
+			continue
+		}
+		data.Certificates = append(data.Certificates, newClientCertificate(certificate))
+	}
+	certificates.Release()
+
+	if thumbprint := normalizeThumbprint(w.opts.ClientCertificateThumbprint); thumbprint != "" {
+		for _, c := range data.Certificates {
+			if c.Thumbprint == thumbprint {
+				data.Selected = c
+				break
+			}
+		}
+	}
+
+	w.onClientCertificateRequested.Fire(winc.NewEvent(w, data))
+
+	if data.deferral == nil {
+		data.Complete()
+	}
+}
+
+func newClientCertificate(certificate *edge.ICoreWebView2ClientCertificate) *ClientCertificate {
+	c := &ClientCertificate{certificate: certificate}
+	c.Subject, _ = certificate.GetSubject()
+	c.Issuer, _ = certificate.GetIssuer()
+	c.DisplayName, _ = certificate.GetDisplayName()
+	c.Kind, _ = certificate.GetKind()
+
+	if validFrom, err := certificate.GetValidFrom(); err == nil {
+		c.NotBefore = timeFromSeconds(validFrom)
+	}
+	if validTo, err := certificate.GetValidTo(); err == nil {
+		c.NotAfter = timeFromSeconds(validTo)
+	}
+
+	if x, err := certificate.X509(); err == nil {
+		c.Certificate = x
+		hash := sha1.Sum(x.Raw)
+		c.Thumbprint = strings.ToUpper(hex.EncodeToString(hash[:]))
+	}
+	return c
+}
+
+// timeFromSeconds converts the seconds since the UNIX epoch used by WebView2 for dates.
+func timeFromSeconds(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+// normalizeThumbprint accepts thumbprints as copied from the certificate manager, which may contain spaces, colons
+// or invisible characters.
+func normalizeThumbprint(thumbprint string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9', r >= 'A' && r <= 'F':
+			return r
+		case r >= 'a' && r <= 'f':
+			return r - 'a' + 'A'
+		}
+		return -1
+	}, thumbprint)
+}
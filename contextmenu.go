@@ -0,0 +1,229 @@
+package wv2
+
+import (
+	"log"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/winc"
+	"github.com/b1naryth1ef/wv2/winc/w32"
+)
+
+type ContextMenuTargetKind int
+
+const (
+	ContextMenuTargetPage         ContextMenuTargetKind = edge.COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_PAGE
+	ContextMenuTargetImage        ContextMenuTargetKind = edge.COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_IMAGE
+	ContextMenuTargetSelectedText ContextMenuTargetKind = edge.COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_SELECTED_TEXT
+	ContextMenuTargetAudio        ContextMenuTargetKind = edge.COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_AUDIO
+	ContextMenuTargetVideo        ContextMenuTargetKind = edge.COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_VIDEO
+)
+
+// ContextMenuTarget describes the element the user requested the context menu for. The URI and text fields are
+// empty if the element doesn't have them.
+type ContextMenuTarget struct {
+	Kind        ContextMenuTargetKind
+	PageURI     string
+	FrameURI    string
+	IsMainFrame bool
+	IsEditable  bool
+
+	LinkURI       string
+	LinkText      string
+	SourceURI     string
+	SelectionText string
+}
+
+type ContextMenuMode int
+
+const (
+	// ContextMenuAppend appends the items of the menu to the default context menu of the webview.
+	ContextMenuAppend ContextMenuMode = iota
+	// ContextMenuReplace shows only the items of the menu in the context menu of the webview.
+	ContextMenuReplace
+	// ContextMenuNative shows the menu as a native popup menu instead of the context menu of the webview.
+	ContextMenuNative
+)
+
+// ContextMenuEventData is passed to OnContextMenu handlers. Handlers may set Menu to a menu created with
+// winc.NewContextMenu, the OnClick events of its items fire when the user picks them. Cancel suppresses the context
+// menu altogether.
+//
+// X and Y are the position the menu was requested at in client coordinates of the window.
+type ContextMenuEventData struct {
+	Target ContextMenuTarget
+	X, Y   int
+
+	Menu   *winc.MenuItem
+	Mode   ContextMenuMode
+	Cancel bool
+}
+
+// OnContextMenu fires when the user requests a context menu on the page, the event data is a
+// *ContextMenuEventData.
+func (w *Window) OnContextMenu() *winc.EventManager {
+	return &w.onContextMenu
+}
+
+func (w *Window) contextMenuRequested(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ContextMenuRequestedEventArgs) {
+	target, err := args.GetContextMenuTarget()
+	if err != nil {
+		log.Printf("GetContextMenuTarget failed: %v", err)
+		return
+	}
+	data := &ContextMenuEventData{Target: newContextMenuTarget(target)}
+	target.Release()
+
+	location, _ := args.GetLocation()
+	data.X, data.Y = int(location.X), int(location.Y)
+
+	w.onContextMenu.Fire(winc.NewEvent(w, data))
+
+	if data.Cancel {
+		args.PutHandled(true)
+		return
+	}
+	if data.Menu == nil {
+		return
+	}
+	if data.Mode == ContextMenuNative || !w.chromium.ContextMenuItemsSupported() {
+		// Custom items can't be added to the menu of the webview, fall back to showing the menu natively before
+		// any of the default items have been removed.
+		w.showNativeContextMenu(args, data)
+		return
+	}
+
+	items, err := args.GetMenuItems()
+	if err != nil {
+		log.Printf("GetMenuItems failed: %v", err)
+		return
+	}
+	defer items.Release()
+
+	count, _ := items.GetCount()
+	if data.Mode == ContextMenuReplace {
+		for ; count > 0; count-- {
+			items.RemoveValueAtIndex(count - 1)
+		}
+	} else if count > 0 && len(data.Menu.Items()) > 0 {
+		separator, err := w.chromium.CreateContextMenuItem("", edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND_SEPARATOR, nil)
+		if err != nil {
+			log.Printf("CreateContextMenuItem failed: %v", err)
+			return
+		}
+		items.InsertValueAtIndex(count, separator)
+		separator.Release()
+	}
+
+	if err := w.appendContextMenuItems(items, data.Menu.Items()); err != nil {
+		log.Printf("Adding context menu items failed: %v", err)
+	}
+}
+
+func (w *Window) showNativeContextMenu(args *edge.ICoreWebView2ContextMenuRequestedEventArgs, data *ContextMenuEventData) {
+	args.PutHandled(true)
+
+	// The menu runs a modal loop, so defer the event and show the menu after returning to the message loop.
+	deferral, err := args.GetDeferral()
+	if err != nil {
+		log.Printf("GetDeferral failed: %v", err)
+		return
+	}
+	args.AddRef()
+	go w.Invoke(func() {
+		defer args.Release()
+		defer deferral.Release()
+		defer deferral.Complete()
+
+		x, y := w32.ClientToScreen(w.Handle(), data.X, data.Y)
+		if item := data.Menu.TrackPopup(w.Handle(), x, y); item != nil {
+			item.OnClick().Fire(winc.NewEvent(w, nil))
+		}
+	})
+}
+
+func (w *Window) appendContextMenuItems(collection *edge.ICoreWebView2ContextMenuItemCollection, items []*winc.MenuItem) error {
+	for _, item := range items {
+		webviewItem, err := w.createContextMenuItem(item)
+		if err != nil {
+			return err
+		}
+
+		count, _ := collection.GetCount()
+		err = collection.InsertValueAtIndex(count, webviewItem)
+		webviewItem.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Window) createContextMenuItem(item *winc.MenuItem) (*edge.ICoreWebView2ContextMenuItem, error) {
+	if item.IsSeparator() {
+		return w.chromium.CreateContextMenuItem("", edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND_SEPARATOR, nil)
+	}
+
+	if item.IsSubMenu() {
+		subMenu, err := w.chromium.CreateContextMenuItem(item.Text(), edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND_SUBMENU, nil)
+		if err != nil {
+			return nil, err
+		}
+		children, err := subMenu.GetChildren()
+		if err != nil {
+			subMenu.Release()
+			return nil, err
+		}
+		err = w.appendContextMenuItems(children, item.Items())
+		children.Release()
+		if err != nil {
+			subMenu.Release()
+			return nil, err
+		}
+		subMenu.PutIsEnabled(item.Enabled())
+		return subMenu, nil
+	}
+
+	var kind edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND = edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND_COMMAND
+	if item.IsRadio() {
+		kind = edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND_RADIO
+	} else if item.Checkable() {
+		kind = edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND_CHECK_BOX
+	}
+
+	webviewItem, err := w.chromium.CreateContextMenuItem(item.Text(), kind, func() {
+		item.OnClick().Fire(winc.NewEvent(w, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+	webviewItem.PutIsEnabled(item.Enabled())
+	if kind != edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND_COMMAND {
+		webviewItem.PutIsChecked(item.Checked())
+	}
+	return webviewItem, nil
+}
+
+func newContextMenuTarget(target *edge.ICoreWebView2ContextMenuTarget) ContextMenuTarget {
+	var t ContextMenuTarget
+
+	kind, _ := target.GetKind()
+	t.Kind = ContextMenuTargetKind(kind)
+	t.PageURI, _ = target.GetPageUri()
+	t.FrameURI, _ = target.GetFrameUri()
+	t.IsMainFrame, _ = target.GetIsRequestedForMainFrame()
+	t.IsEditable, _ = target.GetIsEditable()
+
+	if ok, _ := target.GetHasLinkUri(); ok {
+		t.LinkURI, _ = target.GetLinkUri()
+	}
+	if ok, _ := target.GetHasLinkText(); ok {
+		t.LinkText, _ = target.GetLinkText()
+	}
+	if ok, _ := target.GetHasSourceUri(); ok {
+		t.SourceURI, _ = target.GetSourceUri()
+	}
+	if ok, _ := target.GetHasSelection(); ok {
+		t.SelectionText, _ = target.GetSelectionText()
+	}
+	return t
+}
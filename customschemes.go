@@ -0,0 +1,161 @@
+package wv2
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/b1naryth1ef/wv2/internal/origin"
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+)
+
+// CustomScheme serves a scheme like app:// from Go, e.g. to load the frontend of the application without
+// pretending to be a https host. The scheme is registered with the environment, so all windows sharing the data
+// folder must register the same schemes.
+type CustomScheme struct {
+	// Name of the scheme without the colon, e.g. "app". It must be lower-case.
+	Name string
+	// TreatAsSecure makes pages of the scheme a secure context like https pages.
+	TreatAsSecure bool
+	// AllowedOrigins may issue requests for the scheme, e.g. "https://example.com" or "*" for all origins. Pages of
+	// the scheme itself are always allowed.
+	AllowedOrigins []string
+	// HasAuthorityComponent means URIs look like app://host/path, so pages of different hosts get different
+	// origins. Otherwise all pages of the scheme share an opaque origin.
+	HasAuthorityComponent bool
+
+	// Handler answers the requests for the scheme. It is called on its own goroutine.
+	Handler http.Handler
+}
+
+func (w *Window) customSchemeHandler(uri string) http.Handler {
+	scheme := origin.Scheme(uri)
+	for _, s := range w.opts.CustomSchemes {
+		if strings.EqualFold(s.Name, scheme) {
+			return s.Handler
+		}
+	}
+	return nil
+}
+
+func (w *Window) processRequest(req *edge.ICoreWebView2WebResourceRequest, args *edge.ICoreWebView2WebResourceRequestedEventArgs) {
+	uri, err := req.GetUri()
+	if err != nil {
+		log.Printf("GetUri failed: %v", err)
+		return
+	}
+
+	handler := w.customSchemeHandler(uri)
+	if handler == nil {
+		// Let the WebView2 handle the request with its default handler
+		return
+	}
+
+	// The request is released when we return, so it has to be copied before handing it to the handler.
+	r, err := newHTTPRequest(req, uri)
+	if err != nil {
+		log.Printf("Reading the request for %s failed: %v", uri, err)
+		return
+	}
+
+	deferral, err := args.GetDeferral()
+	if err != nil {
+		log.Printf("GetDeferral failed: %v", err)
+		return
+	}
+	args.AddRef()
+
+	go func() {
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, r)
+
+		w.Invoke(func() {
+			defer args.Release()
+			defer deferral.Release()
+			defer deferral.Complete()
+
+			if err := w.putResponse(args, rw); err != nil {
+				log.Printf("Sending the response for %s failed: %v", uri, err)
+			}
+		})
+	}()
+}
+
+func (w *Window) putResponse(args *edge.ICoreWebView2WebResourceRequestedEventArgs, rw *httptest.ResponseRecorder) error {
+	headers := []string{}
+	for k, v := range rw.Header() {
+		headers = append(headers, fmt.Sprintf("%s: %s", k, strings.Join(v, ",")))
+	}
+
+	env := w.chromium.Environment()
+	response, err := env.CreateWebResourceResponse(rw.Body.Bytes(), rw.Code, http.StatusText(rw.Code), strings.Join(headers, "\n"))
+	if err != nil {
+		return err
+	}
+	defer response.Release()
+
+	return args.PutResponse(response)
+}
+
+// newHTTPRequest copies method, headers and body of req.
+func newHTTPRequest(req *edge.ICoreWebView2WebResourceRequest, uri string) (*http.Request, error) {
+	method, err := req.GetMethod()
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	content, err := req.GetContent()
+	if err != nil {
+		return nil, err
+	}
+	if content != nil {
+		body, err = io.ReadAll(content)
+		content.Release()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := http.NewRequest(method, uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := req.GetHeaders()
+	if err != nil {
+		return nil, err
+	}
+	defer headers.Release()
+
+	iterator, err := headers.GetIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Release()
+
+	for {
+		hasHeader, err := iterator.HasCurrentHeader()
+		if err != nil {
+			return nil, err
+		}
+		if !hasHeader {
+			break
+		}
+
+		name, value, err := iterator.GetCurrentHeader()
+		if err != nil {
+			return nil, err
+		}
+		r.Header.Add(name, value)
+
+		if _, err := iterator.MoveNext(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,132 @@
+package wv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/win32"
+	"github.com/b1naryth1ef/wv2/winc/w32"
+)
+
+type Corners int
+
+const (
+	// CornersDefault lets the system decide, Windows 11 rounds the corners of most windows.
+	CornersDefault Corners = iota
+	CornersSquare
+	CornersRound
+	CornersRoundSmall
+)
+
+// Decorations of the window drawn by the system, corners are only supported by Windows 11.
+type Decorations struct {
+	// Shadow draws the shadow and the thin border of the system around frameless windows, framed windows always
+	// have them.
+	Shadow  bool
+	Corners Corners
+}
+
+// Backdrop is the translucent material drawn by the system behind the window, it is only supported by Windows 11
+// 22H2 and newer. The WebView is made transparent, so the backdrop shows through wherever the page has no
+// background.
+type Backdrop int
+
+const (
+	BackdropNone Backdrop = iota
+	// BackdropMica is tinted with the desktop wallpaper, used for long-lived windows.
+	BackdropMica
+	// BackdropMicaAlt is tinted more strongly than Mica, used for windows with tabs.
+	BackdropMicaAlt
+	// BackdropAcrylic blurs what is behind the window, used for transient windows like popups.
+	BackdropAcrylic
+)
+
+var backdropTypes = map[Backdrop]win32.BackdropType{
+	BackdropNone:    win32.DwmsbtNone,
+	BackdropMica:    win32.DwmsbtMainWindow,
+	BackdropMicaAlt: win32.DwmsbtTabbedWindow,
+	BackdropAcrylic: win32.DwmsbtTransientWindow,
+}
+
+var cornerPreferences = map[Corners]win32.CornerPreference{
+	CornersDefault:    win32.DwmwcpDefault,
+	CornersSquare:     win32.DwmwcpDoNotRound,
+	CornersRound:      win32.DwmwcpRound,
+	CornersRoundSmall: win32.DwmwcpRoundSmall,
+}
+
+// initDecorations applies the corners and the backdrop once the WebView has been created, since the WebView is
+// made transparent for the backdrop.
+func (w *Window) initDecorations() {
+	if w.opts.Decorations.Corners != CornersDefault {
+		if err := w.SetCorners(w.opts.Decorations.Corners); err != nil {
+			log.Printf("Setting the window corners failed: %v", err)
+		}
+	}
+	if w.opts.Backdrop != BackdropNone {
+		if err := w.SetBackdrop(w.opts.Backdrop); err != nil {
+			log.Printf("Setting the window backdrop failed: %v", err)
+		}
+	}
+}
+
+// SetCorners changes the rounding of the window corners, it fails on Windows versions before 11.
+func (w *Window) SetCorners(corners Corners) error {
+	preference, ok := cornerPreferences[corners]
+	if !ok {
+		return fmt.Errorf("wv2: invalid corners %d", corners)
+	}
+	return win32.SetWindowCornerPreference(w.Handle(), preference)
+}
+
+// SetBackdrop changes the material behind the window, it fails on Windows versions before 11 22H2. The WebView
+// becomes transparent for all backdrops but BackdropNone.
+func (w *Window) SetBackdrop(backdrop Backdrop) error {
+	typ, ok := backdropTypes[backdrop]
+	if !ok {
+		return fmt.Errorf("wv2: invalid backdrop %d", backdrop)
+	}
+	if err := win32.SetSystemBackdropType(w.Handle(), typ); err != nil {
+		return err
+	}
+	w.backdrop = backdrop
+	w.extendFrame()
+
+	w32.InvalidateRect(w.Handle(), nil, true)
+
+	background := edge.COREWEBVIEW2_COLOR{A: 255, R: 255, G: 255, B: 255}
+	if backdrop != BackdropNone {
+		background = edge.COREWEBVIEW2_COLOR{}
+	}
+	return w.chromium.PutDefaultBackgroundColor(background)
+}
+
+// eraseBackground paints the client area black while a backdrop is set, since DWM only draws the backdrop where
+// the window is black, or with the BackgroundColour. The class background is shared by all windows, so it can't be
+// changed instead.
+func (w *Window) eraseBackground(hdc uintptr) bool {
+	brush := w32.HBRUSH(w32.GetStockObject(w32.BLACK_BRUSH))
+	switch {
+	case w.backdrop != BackdropNone:
+	case w.backgroundBrush != nil:
+		brush = w.backgroundBrush.GetHBRUSH()
+	default:
+		return false
+	}
+	w32.FillRect(w32.HDC(hdc), w32.GetClientRect(w.Handle()), brush)
+	return true
+}
+
+// extendFrame lets DWM draw the backdrop behind the client area, or the shadow around frameless windows. It must
+// be repeated on WM_ACTIVATE, see https://docs.microsoft.com/en-us/windows/win32/api/dwmapi/nf-dwmapi-dwmextendframeintoclientarea#remarks
+func (w *Window) extendFrame() {
+	switch {
+	case w.backdrop != BackdropNone:
+		if err := win32.ExtendFrameIntoWholeClientArea(w.Handle()); err != nil {
+			log.Printf("Extending the frame into the client area failed: %v", err)
+		}
+	default:
+		win32.ExtendFrameIntoClientArea(w.Handle(), w.opts.Frameless && w.opts.Decorations.Shadow)
+	}
+}
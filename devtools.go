@@ -0,0 +1,41 @@
+package wv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CallDevToolsProtocolMethod calls a method of the DevTools protocol, e.g. "Page.captureScreenshot", and returns its
+// result object. params is encoded as JSON, nil sends no parameters. It must not be called on the UI thread.
+func (w *Window) CallDevToolsProtocolMethod(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	parametersAsJSON := []byte("{}")
+	if params != nil {
+		var err error
+		if parametersAsJSON, err = json.Marshal(params); err != nil {
+			return nil, err
+		}
+	}
+
+	var result string
+	err := w.await(ctx, func(done func(error)) error {
+		return w.chromium.CallDevToolsProtocolMethod(method, string(parametersAsJSON), func(returnObjectAsJSON string, err error) {
+			if err != nil && returnObjectAsJSON != "" {
+				// The result holds the error reported by the browser
+				err = fmt.Errorf("wv2: %s failed: %s: %w", method, returnObjectAsJSON, err)
+			}
+			result = returnObjectAsJSON
+			done(err)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(result), nil
+}
+
+// RemoteDebuggingURL waits until the browser accepts DevTools protocol connections and returns the websocket URL
+// automation tools connect to. WindowOpts.RemoteDebugging must have been set.
+func (w *Window) RemoteDebuggingURL(ctx context.Context) (string, error) {
+	return w.chromium.RemoteDebuggingURL(ctx)
+}
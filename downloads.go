@@ -0,0 +1,282 @@
+package wv2
+
+import (
+	"encoding/json"
+	"log"
+	"path/filepath"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/winc"
+)
+
+type DownloadState int
+
+const (
+	DownloadInProgress  DownloadState = edge.COREWEBVIEW2_DOWNLOAD_STATE_IN_PROGRESS
+	DownloadInterrupted DownloadState = edge.COREWEBVIEW2_DOWNLOAD_STATE_INTERRUPTED
+	DownloadCompleted   DownloadState = edge.COREWEBVIEW2_DOWNLOAD_STATE_COMPLETED
+)
+
+func (s DownloadState) String() string {
+	switch s {
+	case DownloadInProgress:
+		return "in_progress"
+	case DownloadInterrupted:
+		return "interrupted"
+	case DownloadCompleted:
+		return "completed"
+	}
+	return "unknown"
+}
+
+// Download is a download started by the webview of a window.
+type Download struct {
+	download *edge.Download
+
+	onProgress     winc.EventManager
+	onStateChanged winc.EventManager
+}
+
+// DownloadStartingEventData is passed to OnDownloadStarting handlers. Handlers may change the ResultFilePath, ask
+// the user for the location with Prompt, hide the default download flyout or cancel the download altogether.
+type DownloadStartingEventData struct {
+	Download       *Download
+	ResultFilePath string
+
+	Prompt        bool
+	HideDefaultUI bool
+	Cancel        bool
+}
+
+// DownloadEventData is passed to the OnProgress and OnStateChanged handlers of a Download.
+type DownloadEventData struct {
+	Download      *Download
+	State         DownloadState
+	BytesReceived int64
+	TotalBytes    int64
+}
+
+// downloadInfo is the detail of the wv2download event which is dispatched on the page.
+type downloadInfo struct {
+	ID             uint64 `json:"id"`
+	URI            string `json:"uri"`
+	ResultFilePath string `json:"resultFilePath"`
+	State          string `json:"state"`
+	BytesReceived  int64  `json:"bytesReceived"`
+	TotalBytes     int64  `json:"totalBytes"`
+}
+
+const downloadsScript = `(() => {
+	const control = (action) => (id) => window.wv2.post("download", {id, action});
+	window.wv2.downloads = {pause: control("pause"), resume: control("resume"), cancel: control("cancel")};
+})();`
+
+// OnDownloadStarting fires before a download starts, the event data is a *DownloadStartingEventData.
+//
+// The page is notified about downloads with the "wv2download" event on window and may pause, resume or cancel them
+// by calling window.wv2.downloads.pause(id), resume(id) or cancel(id).
+func (w *Window) OnDownloadStarting() *winc.EventManager {
+	return &w.onDownloadStarting
+}
+
+// ID uniquely identifies the download for the lifetime of the process, it is also used by the page to control
+// the download with window.wv2.downloads.
+func (d *Download) ID() uint64 {
+	return d.download.ID
+}
+
+func (d *Download) URI() string {
+	uri, _ := d.download.Operation.GetUri()
+	return uri
+}
+
+func (d *Download) MimeType() string {
+	mimeType, _ := d.download.Operation.GetMimeType()
+	return mimeType
+}
+
+func (d *Download) ResultFilePath() string {
+	path, _ := d.download.Operation.GetResultFilePath()
+	return path
+}
+
+func (d *Download) State() DownloadState {
+	state, _ := d.download.Operation.GetState()
+	return DownloadState(state)
+}
+
+func (d *Download) InterruptReason() edge.COREWEBVIEW2_DOWNLOAD_INTERRUPT_REASON {
+	reason, _ := d.download.Operation.GetInterruptReason()
+	return reason
+}
+
+// BytesReceived returns the number of bytes written to the file so far.
+func (d *Download) BytesReceived() int64 {
+	n, _ := d.download.Operation.GetBytesReceived()
+	return n
+}
+
+// TotalBytes returns the expected size of the download or -1 if the size is unknown.
+func (d *Download) TotalBytes() int64 {
+	n, _ := d.download.Operation.GetTotalBytesToReceive()
+	return n
+}
+
+func (d *Download) CanResume() bool {
+	canResume, _ := d.download.Operation.GetCanResume()
+	return canResume
+}
+
+func (d *Download) Pause() error {
+	if d.download.Finished() {
+		return nil
+	}
+	return d.download.Operation.Pause()
+}
+
+func (d *Download) Resume() error {
+	if d.download.Finished() {
+		return nil
+	}
+	return d.download.Operation.Resume()
+}
+
+func (d *Download) Cancel() error {
+	if d.download.Finished() {
+		return nil
+	}
+	return d.download.Operation.Cancel()
+}
+
+// OnProgress fires whenever new bytes have been received, the event data is a *DownloadEventData.
+func (d *Download) OnProgress() *winc.EventManager {
+	return &d.onProgress
+}
+
+// OnStateChanged fires when the download has been interrupted or completed, the event data is a *DownloadEventData.
+func (d *Download) OnStateChanged() *winc.EventManager {
+	return &d.onStateChanged
+}
+
+func (d *Download) eventData() *DownloadEventData {
+	return &DownloadEventData{
+		Download:      d,
+		State:         d.State(),
+		BytesReceived: d.BytesReceived(),
+		TotalBytes:    d.TotalBytes(),
+	}
+}
+
+func (d *Download) info() downloadInfo {
+	return downloadInfo{
+		ID:             d.ID(),
+		URI:            d.URI(),
+		ResultFilePath: d.ResultFilePath(),
+		State:          d.State().String(),
+		BytesReceived:  d.BytesReceived(),
+		TotalBytes:     d.TotalBytes(),
+	}
+}
+
+func (w *Window) initDownloads() {
+	w.downloads = make(map[uint64]*Download)
+	w.chromium.Init(downloadsScript)
+	w.handleMessage("download", func(_ *Frame, data json.RawMessage) {
+		var req struct {
+			ID     uint64 `json:"id"`
+			Action string `json:"action"`
+		}
+		if err := json.Unmarshal(data, &req); err != nil {
+			log.Printf("Invalid download message: %v", err)
+			return
+		}
+
+		d := w.downloads[req.ID]
+		if d == nil {
+			return
+		}
+
+		switch req.Action {
+		case "pause":
+			d.Pause()
+		case "resume":
+			d.Resume()
+		case "cancel":
+			d.Cancel()
+		}
+	})
+}
+
+// Download returns the download with the specified ID or nil if it has already finished.
+func (w *Window) Download(id uint64) *Download {
+	return w.downloads[id]
+}
+
+func (w *Window) downloadStarting(download *edge.Download, args *edge.ICoreWebView2DownloadStartingEventArgs) {
+	d := &Download{download: download}
+	w.downloads[d.ID()] = d
+
+	download.BytesReceivedChangedCallback = func(*edge.Download) {
+		d.onProgress.Fire(winc.NewEvent(w, d.eventData()))
+		w.emit("wv2download", d.info())
+	}
+	download.StateChangedCallback = func(*edge.Download) {
+		d.onStateChanged.Fire(winc.NewEvent(w, d.eventData()))
+		w.emit("wv2download", d.info())
+		if download.Finished() || d.State() == DownloadCompleted || (d.State() == DownloadInterrupted && !d.CanResume()) {
+			delete(w.downloads, d.ID())
+		}
+	}
+
+	data := &DownloadStartingEventData{Download: d}
+	data.ResultFilePath, _ = args.GetResultFilePath()
+	initialPath := data.ResultFilePath
+
+	w.onDownloadStarting.Fire(winc.NewEvent(w, data))
+
+	if data.HideDefaultUI {
+		args.PutHandled(true)
+	}
+	if data.Cancel {
+		args.PutCancel(true)
+		w.discardDownload(d)
+		return
+	}
+	if data.ResultFilePath != initialPath {
+		args.PutResultFilePath(data.ResultFilePath)
+	}
+	if !data.Prompt {
+		w.emit("wv2download", d.info())
+		return
+	}
+
+	// Showing a modal dialog inside of the event handler is not allowed, so defer the event and show the dialog
+	// after returning to the message loop.
+	deferral, err := args.GetDeferral()
+	if err != nil {
+		log.Printf("GetDeferral failed: %v", err)
+		return
+	}
+	args.AddRef()
+	go w.Invoke(func() {
+		defer args.Release()
+		defer deferral.Release()
+		defer deferral.Complete()
+
+		path, accepted := winc.ShowSaveFileDlgWithName(w, "Save As", "All Files (*.*)|*.*|", 0,
+			filepath.Dir(data.ResultFilePath), filepath.Base(data.ResultFilePath))
+		if !accepted {
+			args.PutCancel(true)
+			w.discardDownload(d)
+			return
+		}
+		args.PutResultFilePath(path)
+		w.emit("wv2download", d.info())
+	})
+}
+
+// discardDownload forgets a download which was cancelled before it started, it doesn't fire any state changes.
+func (w *Window) discardDownload(d *Download) {
+	delete(w.downloads, d.ID())
+	d.download.Discard()
+}
@@ -0,0 +1,76 @@
+package wv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/b1naryth1ef/wv2/internal/origin"
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/pkg/externaluri"
+	"github.com/b1naryth1ef/wv2/winc"
+	"github.com/b1naryth1ef/wv2/winc/w32"
+)
+
+// ExternalURIEventData is passed to OnExternalURI handlers. Action is preset from WindowOpts.ExternalURIPolicy and
+// may be changed by handlers.
+type ExternalURIEventData struct {
+	URI              string
+	InitiatingOrigin string
+	IsUserInitiated  bool
+
+	Action externaluri.Action
+}
+
+// OnExternalURI fires when a page opens a URI with a scheme the WebView can't handle itself, e.g. mailto: or
+// zoommtg: links. The event data is a *ExternalURIEventData.
+func (w *Window) OnExternalURI() *winc.EventManager {
+	return &w.onExternalURI
+}
+
+func (w *Window) launchingExternalUriScheme(sender *edge.ICoreWebView2, args *edge.ICoreWebView2LaunchingExternalUriSchemeEventArgs) {
+	data := &ExternalURIEventData{}
+	data.URI, _ = args.GetUri()
+	data.InitiatingOrigin, _ = args.GetInitiatingOrigin()
+	data.IsUserInitiated, _ = args.GetIsUserInitiated()
+	data.Action = w.opts.ExternalURIPolicy.Action(data.URI, data.InitiatingOrigin)
+
+	w.onExternalURI.Fire(winc.NewEvent(w, data))
+	if data.Action == externaluri.Prompt {
+		return
+	}
+
+	// All other actions replace the prompt of the WebView, so we launch the URI ourselves if it's allowed.
+	args.PutCancel(true)
+	switch data.Action {
+	case externaluri.Launch:
+		w.launchExternalURI(data.URI)
+	case externaluri.Ask:
+		// Don't block the event handler with the modal dialog.
+		go w.Invoke(func() {
+			if w.askExternalURI(data) {
+				w.launchExternalURI(data.URI)
+			}
+		})
+	case externaluri.Handle:
+		if handler := w.opts.ExternalURIHandler; handler != nil {
+			handler(data.URI)
+		} else {
+			log.Printf("No ExternalURIHandler for %s", data.URI)
+		}
+	}
+}
+
+func (w *Window) askExternalURI(data *ExternalURIEventData) bool {
+	page := data.InitiatingOrigin
+	if page == "" {
+		page = "This page"
+	}
+	caption := fmt.Sprintf("%s wants to open a %s: link with an external application.\n\n%s", page, origin.Scheme(data.URI), data.URI)
+	return winc.MsgBoxYesNo(w, "Open external application?", caption) == w32.IDYES
+}
+
+func (w *Window) launchExternalURI(uri string) {
+	if err := w32.ShellExecute(w.Handle(), "open", uri, "", "", w32.SW_SHOWNORMAL); err != nil {
+		log.Printf("Opening %s failed: %v", uri, err)
+	}
+}
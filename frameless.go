@@ -0,0 +1,215 @@
+package wv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/b1naryth1ef/wv2/winc"
+	"github.com/b1naryth1ef/wv2/winc/w32"
+)
+
+// framelessScript lets elements with data-wv2-drag move the window and the border of the page resize it. Runtimes
+// without draggable region support also get the app-region CSS property emulated. Elements with data-wv2-no-drag,
+// form controls and links inside of drag regions stay clickable.
+const framelessScript = `(() => {
+	if (window !== window.top) {
+		return;
+	}
+	const wv2 = window.wv2;
+	const frameless = wv2.frameless;
+	const cursors = {
+		"left": "ew-resize", "right": "ew-resize", "top": "ns-resize", "bottom": "ns-resize",
+		"top-left": "nwse-resize", "bottom-right": "nwse-resize", "top-right": "nesw-resize", "bottom-left": "nesw-resize",
+	};
+	const edgeAt = (x, y) => {
+		const border = frameless.resizable ? frameless.border : 0;
+		if (border <= 0) {
+			return "";
+		}
+		const v = y < border ? "top" : y >= window.innerHeight - border ? "bottom" : "";
+		const h = x < border ? "left" : x >= window.innerWidth - border ? "right" : "";
+		return v && h ? v + "-" + h : v || h;
+	};
+	const draggable = (el) => {
+		for (; el && el.nodeType === Node.ELEMENT_NODE; el = el.parentElement) {
+			if (el.hasAttribute("data-wv2-no-drag") || el.matches("input, textarea, select, button, a[href], [contenteditable]")) {
+				return false;
+			}
+			if (el.hasAttribute("data-wv2-drag")) {
+				return true;
+			}
+			if (!frameless.native) {
+				const style = getComputedStyle(el);
+				const region = style.getPropertyValue("app-region") || style.getPropertyValue("-webkit-app-region");
+				if (region === "no-drag") {
+					return false;
+				}
+				if (region === "drag") {
+					return true;
+				}
+			}
+		}
+		return false;
+	};
+
+	let cursor = null;
+	window.addEventListener("mousemove", (e) => {
+		const edge = edgeAt(e.clientX, e.clientY);
+		const root = document.documentElement;
+		if (edge) {
+			if (cursor === null) {
+				cursor = root.style.cursor;
+			}
+			root.style.cursor = cursors[edge];
+		} else if (cursor !== null) {
+			root.style.cursor = cursor;
+			cursor = null;
+		}
+	}, true);
+	window.addEventListener("mousedown", (e) => {
+		if (e.button !== 0) {
+			return;
+		}
+		const edge = edgeAt(e.clientX, e.clientY);
+		if (edge || draggable(e.target)) {
+			e.preventDefault();
+			wv2.post("drag", {edge, double: !edge && e.detail === 2});
+		}
+	}, true);
+})();`
+
+type dragMessage struct {
+	Edge string `json:"edge"`
+	// Double is set for double clicks of drag regions, which maximise or restore the window like the title bar
+	// unless it can't be resized.
+	Double bool `json:"double"`
+}
+
+// initFrameless enables draggable regions and the resize border for frameless windows once the WebView has been
+// created.
+func (w *Window) initFrameless() {
+	native, err := w.chromium.EnableNonClientRegionSupport()
+	if err != nil {
+		log.Printf("Enabling draggable regions failed: %v", err)
+	}
+	w.framelessResizable = w.resizable()
+	w.framelessInited = true
+
+	w.chromium.Init(fmt.Sprintf("window.wv2.frameless = {native: %t, border: %d, resizable: %t};",
+		native, w.resizeBorder(), w.framelessResizable))
+	w.chromium.Init(framelessScript)
+	w.handleMessage("drag", func(frame *Frame, data json.RawMessage) {
+		if frame != nil {
+			return
+		}
+		var msg dragMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			log.Printf("Invalid drag message: %v", err)
+			return
+		}
+		edge, ok := resizeEdgeNames[msg.Edge]
+		if !ok {
+			log.Printf("Invalid resize edge %q", msg.Edge)
+			return
+		}
+
+		switch {
+		case msg.Double:
+			if w.opts.DisableResize {
+				return
+			}
+			if w.IsMaximised() {
+				w.Restore()
+			} else {
+				w.Maximise()
+			}
+		case edge != edgeNone:
+			if w.resizable() {
+				w.startDrag(resizeEdgeHitTest[edge])
+			}
+		case !w.IsFullScreen():
+			w.startDrag(w32.HTCAPTION)
+		}
+	})
+}
+
+// resizeBorder returns the width of the resize border in DIP, 0 if the window can't be resized by the user.
+func (w *Window) resizeBorder() int {
+	switch {
+	case w.opts.ResizeBorder < 0:
+		return 0
+	case w.opts.ResizeBorder == 0:
+		return defaultResizeBorder
+	}
+	return w.opts.ResizeBorder
+}
+
+// resizable reports whether the frameless window can currently be resized at its border.
+func (w *Window) resizable() bool {
+	return w.resizeBorder() > 0 && !w.opts.DisableResize && !w.IsMaximised() && !w.IsFullScreen()
+}
+
+// updateFramelessResizable tells the page whether the resize border is active after the window has been
+// maximised, restored or made fullscreen. The page doesn't exist before the WebView has been created.
+func (w *Window) updateFramelessResizable() {
+	resizable := w.resizable()
+	if !w.framelessInited || resizable == w.framelessResizable {
+		return
+	}
+	w.framelessResizable = resizable
+	w.applyFramelessResizable()
+}
+
+// applyFramelessResizable sets the state of the resize border in the current page. New documents start with the
+// state the window had when the init script was added, so it is applied again whenever a document loads.
+func (w *Window) applyFramelessResizable() {
+	if !w.framelessInited {
+		return
+	}
+	w.chromium.Eval(fmt.Sprintf("window.wv2.frameless && (window.wv2.frameless.resizable = %t);", w.framelessResizable))
+}
+
+var resizeEdgeHitTest = map[resizeEdge]uintptr{
+	edgeLeft:        w32.HTLEFT,
+	edgeRight:       w32.HTRIGHT,
+	edgeTop:         w32.HTTOP,
+	edgeTopLeft:     w32.HTTOPLEFT,
+	edgeTopRight:    w32.HTTOPRIGHT,
+	edgeBottom:      w32.HTBOTTOM,
+	edgeBottomLeft:  w32.HTBOTTOMLEFT,
+	edgeBottomRight: w32.HTBOTTOMRIGHT,
+}
+
+// hitTest answers WM_NCHITTEST for the resize border of the window, lparam holds the cursor position in screen
+// coordinates. It returns false for the rest of the window.
+func (w *Window) hitTest(lparam uintptr) (uintptr, bool) {
+	if !w.resizable() {
+		return 0, false
+	}
+
+	x, y, ok := w32.ScreenToClient(w.Handle(), int(int16(lparam)), int(int16(lparam>>16)))
+	if !ok {
+		return 0, false
+	}
+	rect := w32.GetClientRect(w.Handle())
+	dpi, _ := w.GetWindowDPI()
+	border := winc.ScaleWithDPI(w.resizeBorder(), uint(dpi))
+
+	edge := resizeEdgeAt(x, y, int(rect.Right-rect.Left), int(rect.Bottom-rect.Top), border)
+	if edge == edgeNone {
+		return 0, false
+	}
+	return resizeEdgeHitTest[edge], true
+}
+
+// startDrag hands the mouse over to the window manager as if the user pressed the button on the specified part of
+// the frame, the WebView keeps the capture of the mouse otherwise.
+func (w *Window) startDrag(hitTest uintptr) {
+	x, y, ok := w32.GetCursorPos()
+	if !ok {
+		return
+	}
+	w32.ReleaseCapture()
+	w32.PostMessage(w.Handle(), w32.WM_NCLBUTTONDOWN, hitTest, uintptr(uint16(int16(x)))|uintptr(uint16(int16(y)))<<16)
+}
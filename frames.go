@@ -0,0 +1,210 @@
+package wv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/winc"
+)
+
+// Frame is an iframe of the top level document of a window.
+type Frame struct {
+	frame  *edge.Frame
+	window *Window
+	uri    string
+
+	// navigationID and navigationURI belong to the navigation in progress, uri is only updated once it completed.
+	navigationID  uint64
+	navigationURI string
+
+	onNameChanged         winc.EventManager
+	onDestroyed           winc.EventManager
+	onNavigationStarting  winc.EventManager
+	onNavigationCompleted winc.EventManager
+	onMessage             winc.EventManager
+}
+
+// FrameMessageEventData is passed to OnMessage handlers of frames. Source is the URI of the document which posted
+// the message.
+type FrameMessageEventData struct {
+	Frame   *Frame
+	Source  string
+	Message string
+}
+
+// OnFrameCreated fires when an iframe has been added to the top level document, the event data is the *Frame.
+func (w *Window) OnFrameCreated() *winc.EventManager {
+	return &w.onFrameCreated
+}
+
+// Frames returns the frames of the top level document which haven't been destroyed yet.
+func (w *Window) Frames() []*Frame {
+	frames := make([]*Frame, 0, len(w.frames))
+	for _, f := range w.frames {
+		frames = append(frames, f)
+	}
+	return frames
+}
+
+// Frame returns the frame with the specified ID or nil if it has been destroyed.
+func (w *Window) Frame(id uint64) *Frame {
+	return w.frames[id]
+}
+
+// ID uniquely identifies the frame for the lifetime of the process.
+func (f *Frame) ID() uint64 {
+	return f.frame.ID
+}
+
+// Name is the value of the name attribute of the iframe element.
+func (f *Frame) Name() string {
+	return f.frame.Name()
+}
+
+// URI returns the URI of the document of the frame, it is empty until the frame completed its first navigation.
+func (f *Frame) URI() string {
+	return f.uri
+}
+
+func (f *Frame) Destroyed() bool {
+	return f.frame.Destroyed()
+}
+
+// Trusted reports if the document of the frame is from one of the WindowOpts.TrustedFrameOrigins.
+func (f *Frame) Trusted() bool {
+	return f.window.isTrustedOrigin(f.uri)
+}
+
+// PostMessage posts v as JSON to the frame, it is received by listeners of the message event of
+// window.chrome.webview.
+func (f *Frame) PostMessage(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return f.frame.PostWebMessageAsJSON(string(data))
+}
+
+// ExecuteScript runs script in the frame without waiting for its result.
+func (f *Frame) ExecuteScript(script string) error {
+	return f.frame.ExecuteScript(script, nil)
+}
+
+// Eval runs script in the frame and returns its result as JSON. It must not be called on the UI thread.
+func (f *Frame) Eval(ctx context.Context, script string) (json.RawMessage, error) {
+	var result json.RawMessage
+	err := f.window.await(ctx, func(done func(error)) error {
+		return f.frame.ExecuteScript(script, func(resultAsJSON string, err error) {
+			result = json.RawMessage(resultAsJSON)
+			done(err)
+		})
+	})
+	return result, err
+}
+
+// OnNameChanged fires when the name of the frame changed, the event data is the *Frame.
+func (f *Frame) OnNameChanged() *winc.EventManager {
+	return &f.onNameChanged
+}
+
+// OnDestroyed fires when the frame has been removed from the document, the event data is the *Frame.
+func (f *Frame) OnDestroyed() *winc.EventManager {
+	return &f.onDestroyed
+}
+
+// OnNavigationStarting fires for every navigation of the frame, the event data is a *NavigationStartingEventData.
+func (f *Frame) OnNavigationStarting() *winc.EventManager {
+	return &f.onNavigationStarting
+}
+
+// OnNavigationCompleted fires when a navigation of the frame finished, the event data is a
+// *NavigationCompletedEventData.
+func (f *Frame) OnNavigationCompleted() *winc.EventManager {
+	return &f.onNavigationCompleted
+}
+
+// OnMessage fires for messages the frame posts with window.chrome.webview.postMessage, the event data is a
+// *FrameMessageEventData.
+func (f *Frame) OnMessage() *winc.EventManager {
+	return &f.onMessage
+}
+
+func (w *Window) frameCreated(frame *edge.Frame) {
+	f := &Frame{frame: frame, window: w}
+	w.frames[f.ID()] = f
+
+	frame.NameChangedCallback = func(*edge.Frame) {
+		f.onNameChanged.Fire(winc.NewEvent(w, f))
+	}
+	frame.DestroyedCallback = func(*edge.Frame) {
+		delete(w.frames, f.ID())
+		f.onDestroyed.Fire(winc.NewEvent(w, f))
+	}
+	frame.NavigationStartingCallback = func(_ *edge.Frame, args *edge.ICoreWebView2NavigationStartingEventArgs) {
+		data := newNavigationStartingEventData(args)
+		f.onNavigationStarting.Fire(winc.NewEvent(w, data))
+		if data.Cancel {
+			args.PutCancel(true)
+			return
+		}
+		f.navigationID, f.navigationURI = data.NavigationID, data.URI
+	}
+	frame.NavigationCompletedCallback = func(_ *edge.Frame, args *edge.ICoreWebView2NavigationCompletedEventArgs) {
+		data := newNavigationCompletedEventData(args)
+		if data.NavigationID == f.navigationID {
+			// The document of a failed navigation is an error page, it isn't trusted until it posts a message.
+			f.uri = ""
+			if data.IsSuccess {
+				f.uri = f.navigationURI
+			}
+			f.navigationID, f.navigationURI = 0, ""
+		}
+		f.onNavigationCompleted.Fire(winc.NewEvent(w, data))
+	}
+	frame.MessageCallback = func(_ *edge.Frame, message string, source string) {
+		// The source is authoritative, the frame might have navigated without us noticing it, e.g. by
+		// document.write.
+		f.uri = source
+		if w.dispatchInternalMessage(f, message) {
+			return
+		}
+		f.onMessage.Fire(winc.NewEvent(w, &FrameMessageEventData{Frame: f, Source: source, Message: message}))
+	}
+
+	w.onFrameCreated.Fire(winc.NewEvent(w, f))
+}
+
+func (w *Window) isTrustedOrigin(uri string) bool {
+	origin := originOf(uri)
+	if origin == "" {
+		return false
+	}
+	for _, trusted := range w.opts.TrustedFrameOrigins {
+		if originOf(trusted) == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// originOf returns the scheme, host and non default port of uri in lower case or an empty string if uri has no
+// such origin, e.g. for about:blank or data URIs.
+func originOf(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port := u.Port(); port != "" && !(scheme == "https" && port == "443") && !(scheme == "http" && port == "80") {
+		host += ":" + port
+	}
+	return scheme + "://" + host
+}
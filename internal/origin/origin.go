@@ -0,0 +1,44 @@
+// Package origin parses URI schemes and matches the origins of pages against patterns.
+package origin
+
+import "strings"
+
+// Scheme returns the lower-cased scheme of uri or "" if it doesn't start with a valid scheme.
+func Scheme(uri string) string {
+	i := strings.IndexByte(uri, ':')
+	if i <= 0 {
+		return ""
+	}
+
+	scheme := uri[:i]
+	for j, c := range scheme {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case j > 0 && (c >= '0' && c <= '9' || c == '+' || c == '-' || c == '.'):
+		default:
+			return ""
+		}
+	}
+	return strings.ToLower(scheme)
+}
+
+// Match reports whether origin matches pattern, which is an origin whose host may start with "*." to match
+// subdomains.
+func Match(pattern, origin string) bool {
+	if pattern == "" || pattern == "*" {
+		return true
+	}
+
+	pattern = strings.ToLower(strings.TrimSuffix(pattern, "/"))
+	origin = strings.ToLower(strings.TrimSuffix(origin, "/"))
+	if pattern == origin {
+		return true
+	}
+
+	scheme, host, ok := strings.Cut(pattern, "://*.")
+	if !ok {
+		return false
+	}
+	originScheme, originHost, ok := strings.Cut(origin, "://")
+	return ok && originScheme == scheme && strings.HasSuffix(originHost, "."+host)
+}
@@ -0,0 +1,61 @@
+package origin
+
+import "testing"
+
+func TestScheme(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{"mailto:user@example.com", "mailto"},
+		{"MailTo:user@example.com", "mailto"},
+		{"web+app:open", "web+app"},
+		{"ms-settings:display", "ms-settings"},
+		{"x1.y-z+w:path", "x1.y-z+w"},
+		{"", ""},
+		{"mailto", ""},
+		{":path", ""},
+		{"1app:path", ""},
+		{"+app:path", ""},
+		{"my app:path", ""},
+		{"app_1:path", ""},
+		{"/relative:path", ""},
+	}
+	for _, tt := range tests {
+		if got := Scheme(tt.uri); got != tt.want {
+			t.Errorf("Scheme(%q) = %q, want %q", tt.uri, got, tt.want)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		origin  string
+		want    bool
+	}{
+		{"", "https://example.com", true},
+		{"*", "https://example.com", true},
+		{"*", "", true},
+		{"https://example.com", "https://example.com", true},
+		{"https://Example.COM", "https://example.com", true},
+		{"https://example.com/", "https://example.com", true},
+		{"https://example.com", "https://example.com/", true},
+		{"https://example.com", "https://www.example.com", false},
+		{"https://example.com", "http://example.com", false},
+		{"https://example.com", "", false},
+		{"https://*.example.com", "https://www.example.com", true},
+		{"https://*.example.com", "https://a.b.example.com", true},
+		{"https://*.example.com/", "https://www.example.com/", true},
+		{"https://*.example.com", "https://example.com", false},
+		{"https://*.example.com", "https://notexample.com", false},
+		{"https://*.example.com", "https://www.example.com.evil.com", false},
+		{"https://*.example.com", "http://www.example.com", false},
+		{"https://*.example.com", "www.example.com", false},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.pattern, tt.origin); got != tt.want {
+			t.Errorf("Match(%q, %q) = %t, want %t", tt.pattern, tt.origin, got, tt.want)
+		}
+	}
+}
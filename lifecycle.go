@@ -0,0 +1,141 @@
+package wv2
+
+import (
+	"log"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/win32"
+	"github.com/b1naryth1ef/wv2/winc"
+)
+
+type LifecycleState int
+
+const (
+	// LifecycleActive means the window is shown to the user.
+	LifecycleActive LifecycleState = iota
+	// LifecycleMinimized means the window has been minimized to the taskbar.
+	LifecycleMinimized
+	// LifecycleHidden means the window has been hidden, e.g. to the tray by MinimizeOnQuit.
+	LifecycleHidden
+	// LifecycleSystemSuspended means the machine is going to sleep or hibernate.
+	LifecycleSystemSuspended
+)
+
+func (s LifecycleState) String() string {
+	switch s {
+	case LifecycleActive:
+		return "active"
+	case LifecycleMinimized:
+		return "minimized"
+	case LifecycleHidden:
+		return "hidden"
+	case LifecycleSystemSuspended:
+		return "system-suspended"
+	}
+	return "unknown"
+}
+
+// ResourcePolicy controls how much the page may run while the user can't see it.
+type ResourcePolicy struct {
+	// SuspendWhenInactive suspends the page while the window is minimized, hidden or the machine suspends. Timers,
+	// animations and network requests of suspended pages are paused. Pages which play audio or hold a web lock are
+	// not suspended.
+	SuspendWhenInactive bool
+	// LowMemoryWhenInactive asks the browser to trim the memory of the page while the window is minimized, hidden
+	// or the machine suspends.
+	LowMemoryWhenInactive bool
+}
+
+type LifecycleEventData struct {
+	State         LifecycleState
+	PreviousState LifecycleState
+}
+
+// OnLifecycleChanged is fired when the window becomes active or inactive, e.g. so the application can pause its
+// own timers. It is fired before the ResourcePolicy is applied when the window becomes inactive, and after it has
+// been applied when the window becomes active again.
+func (w *Window) OnLifecycleChanged() *winc.EventManager {
+	return &w.onLifecycleChanged
+}
+
+// LifecycleState returns the current state of the window.
+func (w *Window) LifecycleState() LifecycleState {
+	return w.lifecycle
+}
+
+// initLifecycle starts tracking the state of the window once the WebView has been created. Changes before that
+// can't be applied to the WebView.
+func (w *Window) initLifecycle() {
+	w.lifecycle = w.windowLifecycleState(win32.IsVisible(w.Handle()))
+	w.lifecycleInited = true
+	if w.lifecycle != LifecycleActive {
+		w.applyResourcePolicy(w.lifecycle)
+	}
+}
+
+// windowLifecycleState returns the state of the window derived from its visibility, which can't be queried while
+// handling WM_SHOWWINDOW.
+func (w *Window) windowLifecycleState(visible bool) LifecycleState {
+	switch {
+	case !visible:
+		return LifecycleHidden
+	case win32.IsWindowMinimised(w.Handle()):
+		return LifecycleMinimized
+	}
+	return LifecycleActive
+}
+
+func (w *Window) setLifecycleState(state LifecycleState) {
+	if !w.lifecycleInited || state == w.lifecycle {
+		return
+	}
+
+	data := &LifecycleEventData{State: state, PreviousState: w.lifecycle}
+	w.lifecycle = state
+	if state == LifecycleActive {
+		w.applyResourcePolicy(state)
+		w.onLifecycleChanged.Fire(winc.NewEvent(w, data))
+	} else {
+		w.onLifecycleChanged.Fire(winc.NewEvent(w, data))
+		if data.PreviousState == LifecycleActive {
+			w.applyResourcePolicy(state)
+		}
+	}
+}
+
+func (w *Window) applyResourcePolicy(state LifecycleState) {
+	policy := w.opts.ResourcePolicy
+	active := state == LifecycleActive
+
+	if policy.LowMemoryWhenInactive {
+		level := edge.COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL(edge.COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL_LOW)
+		if active {
+			level = edge.COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL_NORMAL
+		}
+		if err := w.chromium.PutMemoryUsageTargetLevel(level); err != nil && err != edge.ErrNotSupported {
+			log.Printf("Setting the memory usage target level failed: %v", err)
+		}
+	}
+
+	if !policy.SuspendWhenInactive {
+		return
+	}
+	if active {
+		w.chromium.Show()
+		if err := w.chromium.Resume(); err != nil && err != edge.ErrNotSupported {
+			log.Printf("Resuming the WebView failed: %v", err)
+		}
+		return
+	}
+
+	// Only invisible WebViews can be suspended.
+	w.chromium.Hide()
+	err := w.chromium.TrySuspend(func(suspended bool, err error) {
+		if err != nil {
+			log.Printf("Suspending the WebView failed: %v", err)
+		}
+	})
+	if err != nil && err != edge.ErrNotSupported {
+		log.Printf("Suspending the WebView failed: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package wv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// internalMessagePrefix marks web messages which are posted by the scripts wv2 injects into every page. They are
+// dispatched to the internal handlers of the window instead of the application.
+const internalMessagePrefix = "wv2:"
+
+// bridgeScript sets up window.wv2 which is used by the injected scripts to talk to the window.
+const bridgeScript = `(() => {
+	const wv2 = window.wv2 = window.wv2 || {};
+	wv2.post = (kind, data) => window.chrome.webview.postMessage("` + internalMessagePrefix + `" + JSON.stringify({kind, data}));
+})();`
+
+type internalMessage struct {
+	Kind string          `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+// handleMessage registers fn to be called for every internal message of the specified kind. frame is nil for
+// messages of the top level document, messages of frames are only dispatched if the frame is trusted.
+func (w *Window) handleMessage(kind string, fn func(frame *Frame, data json.RawMessage)) {
+	if w.messageHandlers == nil {
+		w.messageHandlers = make(map[string]func(*Frame, json.RawMessage))
+	}
+	w.messageHandlers[kind] = fn
+}
+
+// dispatchInternalMessage returns false if message has not been posted by the injected scripts.
+func (w *Window) dispatchInternalMessage(frame *Frame, message string) bool {
+	if !strings.HasPrefix(message, internalMessagePrefix) {
+		return false
+	}
+
+	var msg internalMessage
+	if err := json.Unmarshal([]byte(message[len(internalMessagePrefix):]), &msg); err != nil {
+		log.Printf("Invalid internal message %q: %v", message, err)
+		return true
+	}
+
+	if frame != nil && !frame.Trusted() {
+		if msg.Kind == "call" {
+			w.rejectCall(frame, msg.Data)
+		}
+		return true
+	}
+
+	if fn := w.messageHandlers[msg.Kind]; fn != nil {
+		fn(frame, msg.Data)
+	}
+	return true
+}
+
+// emit dispatches a CustomEvent with the specified name and detail on the window object of the page.
+func (w *Window) emit(name string, detail interface{}) {
+	data, err := json.Marshal(detail)
+	if err != nil {
+		log.Printf("Unable to marshal detail of event %s: %v", name, err)
+		return
+	}
+	w.chromium.Eval(fmt.Sprintf("window.dispatchEvent(new CustomEvent(%q, {detail: %s}))", name, data))
+}
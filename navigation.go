@@ -0,0 +1,111 @@
+package wv2
+
+import (
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/winc"
+)
+
+// NavigationStartingEventData is passed to OnNavigationStarting handlers. Handlers may set Cancel to abort the
+// navigation before any request is made.
+type NavigationStartingEventData struct {
+	URI             string
+	NavigationID    uint64
+	IsUserInitiated bool
+	IsRedirected    bool
+
+	Cancel bool
+}
+
+// ContentLoadingEventData is passed to OnContentLoading handlers once the new document starts loading.
+type ContentLoadingEventData struct {
+	NavigationID uint64
+	IsErrorPage  bool
+}
+
+// DOMReadyEventData is passed to OnDOMReady handlers when the DOMContentLoaded event fired in the top level document.
+type DOMReadyEventData struct {
+	NavigationID uint64
+}
+
+// NavigationCompletedEventData is passed to OnNavigationCompleted handlers. HTTPStatusCode is 0 if the runtime
+// doesn't report it or the navigation didn't produce a HTTP response.
+type NavigationCompletedEventData struct {
+	NavigationID   uint64
+	IsSuccess      bool
+	WebErrorStatus edge.COREWEBVIEW2_WEB_ERROR_STATUS
+	HTTPStatusCode int
+}
+
+// OnNavigationStarting fires for every top level navigation, the event data is a *NavigationStartingEventData.
+func (w *Window) OnNavigationStarting() *winc.EventManager {
+	return &w.onNavigationStarting
+}
+
+// OnContentLoading fires when the new document starts loading, the event data is a *ContentLoadingEventData.
+func (w *Window) OnContentLoading() *winc.EventManager {
+	return &w.onContentLoading
+}
+
+// OnDOMReady fires when the DOM of the top level document is ready, the event data is a *DOMReadyEventData.
+func (w *Window) OnDOMReady() *winc.EventManager {
+	return &w.onDOMReady
+}
+
+// OnNavigationCompleted fires when a top level navigation finished, the event data is a
+// *NavigationCompletedEventData.
+func (w *Window) OnNavigationCompleted() *winc.EventManager {
+	return &w.onNavigationCompleted
+}
+
+func (w *Window) navigationStarting(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationStartingEventArgs) {
+	data := newNavigationStartingEventData(args)
+
+	w.onNavigationStarting.Fire(winc.NewEvent(w, data))
+	if data.Cancel {
+		args.PutCancel(true)
+	}
+}
+
+func (w *Window) contentLoading(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ContentLoadingEventArgs) {
+	data := &ContentLoadingEventData{}
+	data.NavigationID, _ = args.GetNavigationId()
+	data.IsErrorPage, _ = args.GetIsErrorPage()
+
+	w.applyFramelessResizable()
+	w.onContentLoading.Fire(winc.NewEvent(w, data))
+}
+
+func (w *Window) domContentLoaded(sender *edge.ICoreWebView2, args *edge.ICoreWebView2DOMContentLoadedEventArgs) {
+	data := &DOMReadyEventData{}
+	data.NavigationID, _ = args.GetNavigationId()
+
+	w.applyFramelessResizable()
+	w.onDOMReady.Fire(winc.NewEvent(w, data))
+}
+
+func (w *Window) navigationCompleted(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationCompletedEventArgs) {
+	data := newNavigationCompletedEventData(args)
+
+	w.onNavigationCompleted.Fire(winc.NewEvent(w, data))
+}
+
+func newNavigationStartingEventData(args *edge.ICoreWebView2NavigationStartingEventArgs) *NavigationStartingEventData {
+	data := &NavigationStartingEventData{}
+	data.URI, _ = args.GetUri()
+	data.NavigationID, _ = args.GetNavigationId()
+	data.IsUserInitiated, _ = args.GetIsUserInitiated()
+	data.IsRedirected, _ = args.GetIsRedirected()
+	return data
+}
+
+func newNavigationCompletedEventData(args *edge.ICoreWebView2NavigationCompletedEventArgs) *NavigationCompletedEventData {
+	data := &NavigationCompletedEventData{}
+	data.NavigationID, _ = args.GetNavigationId()
+	data.IsSuccess, _ = args.GetIsSuccess()
+	data.WebErrorStatus, _ = args.GetWebErrorStatus()
+	if args2 := args.GetICoreWebView2NavigationCompletedEventArgs2(); args2 != nil {
+		data.HTTPStatusCode, _ = args2.GetHttpStatusCode()
+		args2.Release()
+	}
+	return data
+}
@@ -0,0 +1,97 @@
+package wv2
+
+import (
+	"log"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/pkg/permissions"
+	"github.com/b1naryth1ef/wv2/winc"
+)
+
+// PermissionEventData is passed to OnPermissionRequested handlers. State is preset from WindowOpts.PermissionRules
+// and the decisions remembered in WindowOpts.PermissionStore, with the rules taking precedence.
+//
+// Handlers may change State, permissions.Default lets the webview ask the user. Setting Remember saves an allowed or
+// denied State for the origin in the PermissionStore. To show a custom prompt a handler calls Defer and, after the
+// user decided, Complete on the UI thread.
+type PermissionEventData struct {
+	URI             string
+	Origin          string
+	Kind            permissions.Kind
+	IsUserInitiated bool
+
+	State    permissions.State
+	Remember bool
+
+	store    *permissions.Store
+	args     *edge.ICoreWebView2PermissionRequestedEventArgs
+	deferral *edge.ICoreWebView2Deferral
+	done     bool
+}
+
+// OnPermissionRequested fires when a page requests a permission, e.g. to use the camera. The event data is a
+// *PermissionEventData.
+func (w *Window) OnPermissionRequested() *winc.EventManager {
+	return &w.onPermissionRequested
+}
+
+// Defer postpones applying State until Complete is called.
+func (d *PermissionEventData) Defer() error {
+	if d.deferral != nil || d.done {
+		return nil
+	}
+
+	deferral, err := d.args.GetDeferral()
+	if err != nil {
+		return err
+	}
+	d.args.AddRef()
+	d.deferral = deferral
+	return nil
+}
+
+// Complete applies State. It must be called on the UI thread after Defer and is called automatically otherwise.
+func (d *PermissionEventData) Complete() error {
+	if d.done {
+		return nil
+	}
+	d.done = true
+
+	if d.Remember && d.store != nil && d.State != permissions.Default && d.Origin != "" {
+		if err := d.store.Set(d.Origin, d.Kind, d.State); err != nil {
+			log.Printf("Saving the permission decision failed: %v", err)
+		}
+	}
+
+	err := d.args.PutState(edge.CoreWebView2PermissionState(d.State))
+	if d.deferral != nil {
+		defer d.args.Release()
+		defer d.deferral.Release()
+		if completeErr := d.deferral.Complete(); err == nil {
+			err = completeErr
+		}
+	}
+	return err
+}
+
+func (w *Window) permissionRequested(sender *edge.ICoreWebView2, args *edge.ICoreWebView2PermissionRequestedEventArgs) {
+	data := &PermissionEventData{args: args, store: w.opts.PermissionStore}
+	data.URI, _ = args.GetURI()
+	data.Origin = originOf(data.URI)
+	kind, _ := args.GetPermissionKind()
+	data.Kind = permissions.Kind(kind)
+	data.IsUserInitiated, _ = args.GetIsUserInitiated()
+
+	data.State = w.opts.PermissionRules.State(data.Origin, data.Kind)
+	if data.State == permissions.Default && data.store != nil {
+		if state, ok := data.store.Get(data.Origin, data.Kind); ok {
+			data.State = state
+		}
+	}
+
+	w.onPermissionRequested.Fire(winc.NewEvent(w, data))
+
+	if data.deferral == nil {
+		data.Complete()
+	}
+}
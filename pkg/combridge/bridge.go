@@ -52,6 +52,17 @@ func New2[T IUnknown, T2 IUnknown](obj T, obj2 T2) *ComObject[T] {
 	return newComObject[T](cObj)
 }
 
+// NewN returns a new ComObject which implements the Com Interface T and all other specified Com Interfaces, com calls
+// will be redirected to those implementations accordingly. See New2.
+func NewN[T IUnknown](obj T, others ...Implementation) *ComObject[T] {
+	impls := []ifceImpl{ifceDef[T]{obj}}
+	for _, other := range others {
+		impls = append(impls, other)
+	}
+	cObj := new(impls...)
+	return newComObject[T](cObj)
+}
+
 // new returns a new ComObject which implements multiple specified Com Interfaces, com calls will be redirected
 // to the specified go interfaces accordingly.
 // This is needed if a ComObject should implement multiple interfaces that are not descendants of each other,
@@ -130,6 +130,16 @@ type ifceImpl interface {
 	ifce() (*vTable, error)
 }
 
+// Implementation is the implementation of a Com Interface by a go object, see Implements.
+type Implementation interface {
+	ifceImpl
+}
+
+// Implements returns the Implementation of the Com Interface T by obj, which can be passed to NewN.
+func Implements[T IUnknown](obj T) Implementation {
+	return ifceDef[T]{obj}
+}
+
 type ifceDef[T any] struct {
 	objImpl any
 }
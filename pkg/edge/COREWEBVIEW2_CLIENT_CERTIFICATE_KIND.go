@@ -0,0 +1,11 @@
+//go:build windows
+
+package edge
+
+type COREWEBVIEW2_CLIENT_CERTIFICATE_KIND uint32
+
+const (
+	COREWEBVIEW2_CLIENT_CERTIFICATE_KIND_SMART_CARD = 0
+	COREWEBVIEW2_CLIENT_CERTIFICATE_KIND_PIN        = 1
+	COREWEBVIEW2_CLIENT_CERTIFICATE_KIND_OTHER      = 2
+)
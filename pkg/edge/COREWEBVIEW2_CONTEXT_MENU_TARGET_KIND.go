@@ -0,0 +1,13 @@
+//go:build windows
+
+package edge
+
+type COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND uint32
+
+const (
+	COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_PAGE          = 0
+	COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_IMAGE         = 1
+	COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_SELECTED_TEXT = 2
+	COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_AUDIO         = 3
+	COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND_VIDEO         = 4
+)
@@ -0,0 +1,10 @@
+//go:build windows
+
+package edge
+
+type COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL uint32
+
+const (
+	COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL_NORMAL = 0
+	COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL_LOW    = 1
+)
@@ -0,0 +1,22 @@
+//go:build windows
+
+package edge
+
+type COREWEBVIEW2_PDF_TOOLBAR_ITEMS uint32
+
+const (
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_NONE          = 0
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_SAVE          = 1 << 0
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_PRINT         = 1 << 1
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_SAVE_AS       = 1 << 2
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_ZOOM_IN       = 1 << 3
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_ZOOM_OUT      = 1 << 4
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_ROTATE        = 1 << 5
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_FIT_PAGE      = 1 << 6
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_PAGE_LAYOUT   = 1 << 7
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_BOOKMARKS     = 1 << 8
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_PAGE_SELECTOR = 1 << 9
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_SEARCH        = 1 << 10
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_FULL_SCREEN   = 1 << 11
+	COREWEBVIEW2_PDF_TOOLBAR_ITEMS_MORE_SETTINGS = 1 << 12
+)
@@ -0,0 +1,11 @@
+//go:build windows
+
+package edge
+
+type COREWEBVIEW2_PREFERRED_COLOR_SCHEME uint32
+
+const (
+	COREWEBVIEW2_PREFERRED_COLOR_SCHEME_AUTO  = 0
+	COREWEBVIEW2_PREFERRED_COLOR_SCHEME_LIGHT = 1
+	COREWEBVIEW2_PREFERRED_COLOR_SCHEME_DARK  = 2
+)
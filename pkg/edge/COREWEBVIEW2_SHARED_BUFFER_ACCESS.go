@@ -0,0 +1,10 @@
+//go:build windows
+
+package edge
+
+type COREWEBVIEW2_SHARED_BUFFER_ACCESS uint32
+
+const (
+	COREWEBVIEW2_SHARED_BUFFER_ACCESS_READ_ONLY  = 0
+	COREWEBVIEW2_SHARED_BUFFER_ACCESS_READ_WRITE = 1
+)
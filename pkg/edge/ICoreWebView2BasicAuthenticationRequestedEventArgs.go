@@ -0,0 +1,132 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2BasicAuthenticationRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri       ComProc
+	GetChallenge ComProc
+	GetResponse  ComProc
+	GetCancel    ComProc
+	PutCancel    ComProc
+	GetDeferral  ComProc
+}
+
+type ICoreWebView2BasicAuthenticationRequestedEventArgs struct {
+	vtbl *_ICoreWebView2BasicAuthenticationRequestedEventArgsVtbl
+}
+
+// AddRef must be called if the args are used after the event handler has returned, e.g. when completing a
+// deferral asynchronously.
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetUri() (string, error) {
+	// Create *uint16 to hold result
+	var _uri *uint16
+	res, _, err := i.vtbl.GetUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetChallenge() (string, error) {
+	// Create *uint16 to hold result
+	var _challenge *uint16
+	res, _, err := i.vtbl.GetChallenge.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_challenge)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	challenge := windows.UTF16PtrToString(_challenge)
+	windows.CoTaskMemFree(unsafe.Pointer(_challenge))
+	return challenge, nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetResponse() (*ICoreWebView2BasicAuthenticationResponse, error) {
+	var response *ICoreWebView2BasicAuthenticationResponse
+	res, _, err := i.vtbl.GetResponse.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&response)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return response, nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetCancel() (bool, error) {
+	var cancel int32
+	res, _, err := i.vtbl.GetCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return cancel != 0, nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) PutCancel(cancel bool) error {
+	res, _, err := i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+	res, _, err := i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return deferral, nil
+}
@@ -0,0 +1,103 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2BasicAuthenticationResponseVtbl struct {
+	_IUnknownVtbl
+	GetUserName ComProc
+	PutUserName ComProc
+	GetPassword ComProc
+	PutPassword ComProc
+}
+
+// ICoreWebView2BasicAuthenticationResponse holds the credentials used to answer a basic authentication challenge.
+type ICoreWebView2BasicAuthenticationResponse struct {
+	vtbl *_ICoreWebView2BasicAuthenticationResponseVtbl
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) GetUserName() (string, error) {
+	// Create *uint16 to hold result
+	var _userName *uint16
+	res, _, err := i.vtbl.GetUserName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_userName)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	userName := windows.UTF16PtrToString(_userName)
+	windows.CoTaskMemFree(unsafe.Pointer(_userName))
+	return userName, nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) PutUserName(userName string) error {
+	_userName, err := windows.UTF16PtrFromString(userName)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutUserName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_userName)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) GetPassword() (string, error) {
+	// Create *uint16 to hold result
+	var _password *uint16
+	res, _, err := i.vtbl.GetPassword.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_password)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	password := windows.UTF16PtrToString(_password)
+	windows.CoTaskMemFree(unsafe.Pointer(_password))
+	return password, nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) PutPassword(password string) error {
+	_password, err := windows.UTF16PtrFromString(password)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutPassword.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_password)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2CertificateVtbl struct {
+	_IUnknownVtbl
+	GetSubject                          ComProc
+	GetIssuer                           ComProc
+	GetValidFrom                        ComProc
+	GetValidTo                          ComProc
+	GetDerEncodedSerialNumber           ComProc
+	GetDisplayName                      ComProc
+	ToPemEncoding                       ComProc
+	GetPemEncodedIssuerCertificateChain ComProc
+}
+
+// ICoreWebView2Certificate is a certificate presented by a server.
+type ICoreWebView2Certificate struct {
+	vtbl *_ICoreWebView2CertificateVtbl
+}
+
+// ToPemEncoding returns the certificate in PEM encoding.
+func (i *ICoreWebView2Certificate) ToPemEncoding() (string, error) {
+	var _pemEncodedData *uint16
+	res, _, err := i.vtbl.ToPemEncoding.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_pemEncodedData)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	pemEncodedData := windows.UTF16PtrToString(_pemEncodedData)
+	windows.CoTaskMemFree(unsafe.Pointer(_pemEncodedData))
+	return pemEncodedData, nil
+}
+
+func (i *ICoreWebView2Certificate) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Certificate) GetSubject() (string, error) {
+	// Create *uint16 to hold result
+	var _subject *uint16
+	res, _, err := i.vtbl.GetSubject.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_subject)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	subject := windows.UTF16PtrToString(_subject)
+	windows.CoTaskMemFree(unsafe.Pointer(_subject))
+	return subject, nil
+}
+
+func (i *ICoreWebView2Certificate) GetIssuer() (string, error) {
+	// Create *uint16 to hold result
+	var _issuer *uint16
+	res, _, err := i.vtbl.GetIssuer.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_issuer)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	issuer := windows.UTF16PtrToString(_issuer)
+	windows.CoTaskMemFree(unsafe.Pointer(_issuer))
+	return issuer, nil
+}
+
+func (i *ICoreWebView2Certificate) GetValidFrom() (float64, error) {
+	var validFrom float64
+	res, _, err := i.vtbl.GetValidFrom.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&validFrom)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return validFrom, nil
+}
+
+func (i *ICoreWebView2Certificate) GetValidTo() (float64, error) {
+	var validTo float64
+	res, _, err := i.vtbl.GetValidTo.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&validTo)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return validTo, nil
+}
+
+func (i *ICoreWebView2Certificate) GetDerEncodedSerialNumber() (string, error) {
+	// Create *uint16 to hold result
+	var _derEncodedSerialNumber *uint16
+	res, _, err := i.vtbl.GetDerEncodedSerialNumber.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_derEncodedSerialNumber)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	derEncodedSerialNumber := windows.UTF16PtrToString(_derEncodedSerialNumber)
+	windows.CoTaskMemFree(unsafe.Pointer(_derEncodedSerialNumber))
+	return derEncodedSerialNumber, nil
+}
+
+func (i *ICoreWebView2Certificate) GetDisplayName() (string, error) {
+	// Create *uint16 to hold result
+	var _displayName *uint16
+	res, _, err := i.vtbl.GetDisplayName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_displayName)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	displayName := windows.UTF16PtrToString(_displayName)
+	windows.CoTaskMemFree(unsafe.Pointer(_displayName))
+	return displayName, nil
+}
+
+func (i *ICoreWebView2Certificate) GetPemEncodedIssuerCertificateChain() (*ICoreWebView2StringCollection, error) {
+	var pemEncodedIssuerCertificateChain *ICoreWebView2StringCollection
+	res, _, err := i.vtbl.GetPemEncodedIssuerCertificateChain.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&pemEncodedIssuerCertificateChain)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return pemEncodedIssuerCertificateChain, nil
+}
@@ -0,0 +1,56 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ClientCertificateCollectionVtbl struct {
+	_IUnknownVtbl
+	GetCount        ComProc
+	GetValueAtIndex ComProc
+}
+
+type ICoreWebView2ClientCertificateCollection struct {
+	vtbl *_ICoreWebView2ClientCertificateCollectionVtbl
+}
+
+func (i *ICoreWebView2ClientCertificateCollection) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ClientCertificateCollection) GetCount() (uint32, error) {
+	var count uint32
+	res, _, err := i.vtbl.GetCount.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&count)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return count, nil
+}
+
+// GetValueAtIndex returns the certificate at the specified index, it must be released after finishing using it.
+func (i *ICoreWebView2ClientCertificateCollection) GetValueAtIndex(index uint32) (*ICoreWebView2ClientCertificate, error) {
+	var certificate *ICoreWebView2ClientCertificate
+	res, _, err := i.vtbl.GetValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+		uintptr(unsafe.Pointer(&certificate)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return certificate, nil
+}
@@ -0,0 +1,224 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ClientCertificateRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetHost                          ComProc
+	GetPort                          ComProc
+	GetIsProxy                       ComProc
+	GetAllowedCertificateAuthorities ComProc
+	GetMutuallyTrustedCertificates   ComProc
+	GetSelectedCertificate           ComProc
+	PutSelectedCertificate           ComProc
+	GetCancel                        ComProc
+	PutCancel                        ComProc
+	GetHandled                       ComProc
+	PutHandled                       ComProc
+	GetDeferral                      ComProc
+}
+
+type ICoreWebView2ClientCertificateRequestedEventArgs struct {
+	vtbl *_ICoreWebView2ClientCertificateRequestedEventArgsVtbl
+}
+
+// AddRef must be called if the args are used after the event handler has returned, e.g. when completing a
+// deferral asynchronously.
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+// PutSelectedCertificate selects one of the mutually trusted certificates, Handled must be set for the selection
+// to be used.
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) PutSelectedCertificate(certificate *ICoreWebView2ClientCertificate) error {
+	res, _, err := i.vtbl.PutSelectedCertificate.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(certificate)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetHost() (string, error) {
+	// Create *uint16 to hold result
+	var _host *uint16
+	res, _, err := i.vtbl.GetHost.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_host)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	host := windows.UTF16PtrToString(_host)
+	windows.CoTaskMemFree(unsafe.Pointer(_host))
+	return host, nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetPort() (int32, error) {
+	var port int32
+	res, _, err := i.vtbl.GetPort.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&port)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return port, nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetIsProxy() (bool, error) {
+	var isProxy int32
+	res, _, err := i.vtbl.GetIsProxy.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isProxy)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isProxy != 0, nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetAllowedCertificateAuthorities() (*ICoreWebView2StringCollection, error) {
+	var allowedCertificateAuthorities *ICoreWebView2StringCollection
+	res, _, err := i.vtbl.GetAllowedCertificateAuthorities.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&allowedCertificateAuthorities)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return allowedCertificateAuthorities, nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetMutuallyTrustedCertificates() (*ICoreWebView2ClientCertificateCollection, error) {
+	var mutuallyTrustedCertificates *ICoreWebView2ClientCertificateCollection
+	res, _, err := i.vtbl.GetMutuallyTrustedCertificates.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&mutuallyTrustedCertificates)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return mutuallyTrustedCertificates, nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetSelectedCertificate() (*ICoreWebView2ClientCertificate, error) {
+	var selectedCertificate *ICoreWebView2ClientCertificate
+	res, _, err := i.vtbl.GetSelectedCertificate.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&selectedCertificate)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return selectedCertificate, nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetCancel() (bool, error) {
+	var cancel int32
+	res, _, err := i.vtbl.GetCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return cancel != 0, nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) PutCancel(cancel bool) error {
+	res, _, err := i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetHandled() (bool, error) {
+	var handled int32
+	res, _, err := i.vtbl.GetHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return handled != 0, nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) PutHandled(handled bool) error {
+	res, _, err := i.vtbl.PutHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ClientCertificateRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+	res, _, err := i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return deferral, nil
+}
@@ -0,0 +1,54 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContentLoadingEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetIsErrorPage  ComProc
+	GetNavigationId ComProc
+}
+
+type ICoreWebView2ContentLoadingEventArgs struct {
+	vtbl *_ICoreWebView2ContentLoadingEventArgsVtbl
+}
+
+func (i *ICoreWebView2ContentLoadingEventArgs) AddRef() uintptr {
+	return i.AddRef()
+}
+
+func (i *ICoreWebView2ContentLoadingEventArgs) GetIsErrorPage() (bool, error) {
+	var isErrorPage int32
+	res, _, err := i.vtbl.GetIsErrorPage.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isErrorPage)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isErrorPage != 0, nil
+}
+
+func (i *ICoreWebView2ContentLoadingEventArgs) GetNavigationId() (uint64, error) {
+	var navigationId uint64
+	res, _, err := i.vtbl.GetNavigationId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&navigationId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return navigationId, nil
+}
@@ -0,0 +1,210 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuItemVtbl struct {
+	_IUnknownVtbl
+	GetName                   ComProc
+	GetLabel                  ComProc
+	GetCommandId              ComProc
+	GetShortcutKeyDescription ComProc
+	GetIcon                   ComProc
+	GetKind                   ComProc
+	PutIsEnabled              ComProc
+	GetIsEnabled              ComProc
+	PutIsChecked              ComProc
+	GetIsChecked              ComProc
+	GetChildren               ComProc
+	AddCustomItemSelected     ComProc
+	RemoveCustomItemSelected  ComProc
+}
+
+// ICoreWebView2ContextMenuItem is an entry of a context menu, either one of the default items provided by the
+// webview or a custom one created with ICoreWebView2Environment9.CreateContextMenuItem.
+type ICoreWebView2ContextMenuItem struct {
+	vtbl *_ICoreWebView2ContextMenuItemVtbl
+}
+
+func (i *ICoreWebView2ContextMenuItem) AddCustomItemSelected(eventHandler *ICoreWebView2CustomItemSelectedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddCustomItemSelected.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetName() (string, error) {
+	// Create *uint16 to hold result
+	var _name *uint16
+	res, _, err := i.vtbl.GetName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_name)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	name := windows.UTF16PtrToString(_name)
+	windows.CoTaskMemFree(unsafe.Pointer(_name))
+	return name, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetLabel() (string, error) {
+	// Create *uint16 to hold result
+	var _label *uint16
+	res, _, err := i.vtbl.GetLabel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_label)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	label := windows.UTF16PtrToString(_label)
+	windows.CoTaskMemFree(unsafe.Pointer(_label))
+	return label, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetCommandId() (int32, error) {
+	var commandId int32
+	res, _, err := i.vtbl.GetCommandId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&commandId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return commandId, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetShortcutKeyDescription() (string, error) {
+	// Create *uint16 to hold result
+	var _shortcutKeyDescription *uint16
+	res, _, err := i.vtbl.GetShortcutKeyDescription.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_shortcutKeyDescription)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	shortcutKeyDescription := windows.UTF16PtrToString(_shortcutKeyDescription)
+	windows.CoTaskMemFree(unsafe.Pointer(_shortcutKeyDescription))
+	return shortcutKeyDescription, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetKind() (COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND, error) {
+	var kind COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND
+	res, _, err := i.vtbl.GetKind.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&kind)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return kind, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) PutIsEnabled(isEnabled bool) error {
+	res, _, err := i.vtbl.PutIsEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetIsEnabled() (bool, error) {
+	var isEnabled int32
+	res, _, err := i.vtbl.GetIsEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isEnabled != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) PutIsChecked(isChecked bool) error {
+	res, _, err := i.vtbl.PutIsChecked.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isChecked)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetIsChecked() (bool, error) {
+	var isChecked int32
+	res, _, err := i.vtbl.GetIsChecked.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isChecked)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isChecked != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetChildren() (*ICoreWebView2ContextMenuItemCollection, error) {
+	var children *ICoreWebView2ContextMenuItemCollection
+	res, _, err := i.vtbl.GetChildren.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&children)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return children, nil
+}
@@ -0,0 +1,88 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuItemCollectionVtbl struct {
+	_IUnknownVtbl
+	GetCount           ComProc
+	GetValueAtIndex    ComProc
+	RemoveValueAtIndex ComProc
+	InsertValueAtIndex ComProc
+}
+
+// ICoreWebView2ContextMenuItemCollection is the list of items of a context menu or of one of its submenus.
+type ICoreWebView2ContextMenuItemCollection struct {
+	vtbl *_ICoreWebView2ContextMenuItemCollectionVtbl
+}
+
+func (i *ICoreWebView2ContextMenuItemCollection) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ContextMenuItemCollection) GetCount() (uint32, error) {
+	var count uint32
+	res, _, err := i.vtbl.GetCount.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&count)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return count, nil
+}
+
+// GetValueAtIndex returns the item at the specified index, it must be released after finishing using it.
+func (i *ICoreWebView2ContextMenuItemCollection) GetValueAtIndex(index uint32) (*ICoreWebView2ContextMenuItem, error) {
+	var item *ICoreWebView2ContextMenuItem
+	res, _, err := i.vtbl.GetValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+		uintptr(unsafe.Pointer(&item)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return item, nil
+}
+
+func (i *ICoreWebView2ContextMenuItemCollection) RemoveValueAtIndex(index uint32) error {
+	res, _, err := i.vtbl.RemoveValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ContextMenuItemCollection) InsertValueAtIndex(index uint32, item *ICoreWebView2ContextMenuItem) error {
+	res, _, err := i.vtbl.InsertValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+		uintptr(unsafe.Pointer(item)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
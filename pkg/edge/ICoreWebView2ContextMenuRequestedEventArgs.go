@@ -0,0 +1,158 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/b1naryth1ef/wv2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetMenuItems         ComProc
+	GetContextMenuTarget ComProc
+	GetLocation          ComProc
+	GetSelectedCommandId ComProc
+	PutSelectedCommandId ComProc
+	GetHandled           ComProc
+	PutHandled           ComProc
+	GetDeferral          ComProc
+}
+
+type ICoreWebView2ContextMenuRequestedEventArgs struct {
+	vtbl *_ICoreWebView2ContextMenuRequestedEventArgsVtbl
+}
+
+// AddRef must be called if the args are used after the event handler has returned, e.g. when completing a
+// deferral asynchronously.
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+// GetLocation returns the position the context menu was requested at, relative to the top left corner of the
+// webview.
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetLocation() (w32.Point, error) {
+	var location w32.Point
+	res, _, err := i.vtbl.GetLocation.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&location)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return location, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return location, syscall.Errno(res)
+	}
+	return location, nil
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetMenuItems() (*ICoreWebView2ContextMenuItemCollection, error) {
+	var menuItems *ICoreWebView2ContextMenuItemCollection
+	res, _, err := i.vtbl.GetMenuItems.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&menuItems)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return menuItems, nil
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetContextMenuTarget() (*ICoreWebView2ContextMenuTarget, error) {
+	var contextMenuTarget *ICoreWebView2ContextMenuTarget
+	res, _, err := i.vtbl.GetContextMenuTarget.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&contextMenuTarget)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return contextMenuTarget, nil
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetSelectedCommandId() (int32, error) {
+	var selectedCommandId int32
+	res, _, err := i.vtbl.GetSelectedCommandId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&selectedCommandId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return selectedCommandId, nil
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) PutSelectedCommandId(selectedCommandId int32) error {
+	res, _, err := i.vtbl.PutSelectedCommandId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(selectedCommandId),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetHandled() (bool, error) {
+	var handled int32
+	res, _, err := i.vtbl.GetHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return handled != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) PutHandled(handled bool) error {
+	res, _, err := i.vtbl.PutHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+	res, _, err := i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return deferral, nil
+}
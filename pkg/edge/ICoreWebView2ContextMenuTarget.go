@@ -0,0 +1,255 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuTargetVtbl struct {
+	_IUnknownVtbl
+	GetKind                    ComProc
+	GetIsEditable              ComProc
+	GetIsRequestedForMainFrame ComProc
+	GetPageUri                 ComProc
+	GetFrameUri                ComProc
+	GetHasLinkUri              ComProc
+	GetLinkUri                 ComProc
+	GetHasLinkText             ComProc
+	GetLinkText                ComProc
+	GetHasSourceUri            ComProc
+	GetSourceUri               ComProc
+	GetHasSelection            ComProc
+	GetSelectionText           ComProc
+}
+
+// ICoreWebView2ContextMenuTarget describes the element on the page a context menu was requested for.
+type ICoreWebView2ContextMenuTarget struct {
+	vtbl *_ICoreWebView2ContextMenuTargetVtbl
+}
+
+func (i *ICoreWebView2ContextMenuTarget) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetKind() (COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND, error) {
+	var kind COREWEBVIEW2_CONTEXT_MENU_TARGET_KIND
+	res, _, err := i.vtbl.GetKind.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&kind)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return kind, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetIsEditable() (bool, error) {
+	var isEditable int32
+	res, _, err := i.vtbl.GetIsEditable.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isEditable)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isEditable != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetIsRequestedForMainFrame() (bool, error) {
+	var isRequestedForMainFrame int32
+	res, _, err := i.vtbl.GetIsRequestedForMainFrame.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isRequestedForMainFrame)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isRequestedForMainFrame != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetPageUri() (string, error) {
+	// Create *uint16 to hold result
+	var _pageUri *uint16
+	res, _, err := i.vtbl.GetPageUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_pageUri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	pageUri := windows.UTF16PtrToString(_pageUri)
+	windows.CoTaskMemFree(unsafe.Pointer(_pageUri))
+	return pageUri, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetFrameUri() (string, error) {
+	// Create *uint16 to hold result
+	var _frameUri *uint16
+	res, _, err := i.vtbl.GetFrameUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_frameUri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	frameUri := windows.UTF16PtrToString(_frameUri)
+	windows.CoTaskMemFree(unsafe.Pointer(_frameUri))
+	return frameUri, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetHasLinkUri() (bool, error) {
+	var hasLinkUri int32
+	res, _, err := i.vtbl.GetHasLinkUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hasLinkUri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return hasLinkUri != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetLinkUri() (string, error) {
+	// Create *uint16 to hold result
+	var _linkUri *uint16
+	res, _, err := i.vtbl.GetLinkUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_linkUri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	linkUri := windows.UTF16PtrToString(_linkUri)
+	windows.CoTaskMemFree(unsafe.Pointer(_linkUri))
+	return linkUri, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetHasLinkText() (bool, error) {
+	var hasLinkText int32
+	res, _, err := i.vtbl.GetHasLinkText.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hasLinkText)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return hasLinkText != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetLinkText() (string, error) {
+	// Create *uint16 to hold result
+	var _linkText *uint16
+	res, _, err := i.vtbl.GetLinkText.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_linkText)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	linkText := windows.UTF16PtrToString(_linkText)
+	windows.CoTaskMemFree(unsafe.Pointer(_linkText))
+	return linkText, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetHasSourceUri() (bool, error) {
+	var hasSourceUri int32
+	res, _, err := i.vtbl.GetHasSourceUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hasSourceUri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return hasSourceUri != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetSourceUri() (string, error) {
+	// Create *uint16 to hold result
+	var _sourceUri *uint16
+	res, _, err := i.vtbl.GetSourceUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_sourceUri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	sourceUri := windows.UTF16PtrToString(_sourceUri)
+	windows.CoTaskMemFree(unsafe.Pointer(_sourceUri))
+	return sourceUri, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetHasSelection() (bool, error) {
+	var hasSelection int32
+	res, _, err := i.vtbl.GetHasSelection.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hasSelection)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return hasSelection != 0, nil
+}
+
+func (i *ICoreWebView2ContextMenuTarget) GetSelectionText() (string, error) {
+	// Create *uint16 to hold result
+	var _selectionText *uint16
+	res, _, err := i.vtbl.GetSelectionText.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_selectionText)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	selectionText := windows.UTF16PtrToString(_selectionText)
+	windows.CoTaskMemFree(unsafe.Pointer(_selectionText))
+	return selectionText, nil
+}
@@ -45,6 +45,10 @@ func (i *ICoreWebView2Controller2) AddRef() uintptr {
 	return i.AddRef()
 }
 
+func (i *ICoreWebView2Controller2) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
 func (i *ICoreWebView2Controller2) GetDefaultBackgroundColor() (*COREWEBVIEW2_COLOR, error) {
 	var err error
 	var backgroundColor *COREWEBVIEW2_COLOR
@@ -0,0 +1,94 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ControllerOptionsVtbl struct {
+	_IUnknownVtbl
+	GetProfileName            ComProc
+	PutProfileName            ComProc
+	GetIsInPrivateModeEnabled ComProc
+	PutIsInPrivateModeEnabled ComProc
+}
+
+// ICoreWebView2ControllerOptions selects the profile used by a new controller.
+type ICoreWebView2ControllerOptions struct {
+	vtbl *_ICoreWebView2ControllerOptionsVtbl
+}
+
+func (i *ICoreWebView2ControllerOptions) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ControllerOptions) GetProfileName() (string, error) {
+	// Create *uint16 to hold result
+	var _profileName *uint16
+	res, _, err := i.vtbl.GetProfileName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_profileName)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	profileName := windows.UTF16PtrToString(_profileName)
+	windows.CoTaskMemFree(unsafe.Pointer(_profileName))
+	return profileName, nil
+}
+
+func (i *ICoreWebView2ControllerOptions) PutProfileName(profileName string) error {
+	_profileName, err := windows.UTF16PtrFromString(profileName)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutProfileName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_profileName)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ControllerOptions) GetIsInPrivateModeEnabled() (bool, error) {
+	var isInPrivateModeEnabled int32
+	res, _, err := i.vtbl.GetIsInPrivateModeEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isInPrivateModeEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isInPrivateModeEnabled != 0, nil
+}
+
+func (i *ICoreWebView2ControllerOptions) PutIsInPrivateModeEnabled(isInPrivateModeEnabled bool) error {
+	res, _, err := i.vtbl.PutIsInPrivateModeEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isInPrivateModeEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
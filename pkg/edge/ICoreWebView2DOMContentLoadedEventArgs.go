@@ -0,0 +1,38 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DOMContentLoadedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetNavigationId ComProc
+}
+
+type ICoreWebView2DOMContentLoadedEventArgs struct {
+	vtbl *_ICoreWebView2DOMContentLoadedEventArgsVtbl
+}
+
+func (i *ICoreWebView2DOMContentLoadedEventArgs) AddRef() uintptr {
+	return i.AddRef()
+}
+
+func (i *ICoreWebView2DOMContentLoadedEventArgs) GetNavigationId() (uint64, error) {
+	var navigationId uint64
+	res, _, err := i.vtbl.GetNavigationId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&navigationId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return navigationId, nil
+}
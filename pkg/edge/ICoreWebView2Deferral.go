@@ -0,0 +1,38 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DeferralVtbl struct {
+	_IUnknownVtbl
+	Complete ComProc
+}
+
+// ICoreWebView2Deferral is used to complete deferrals on event args that support getting deferrals. Complete
+// must be called on the UI thread.
+type ICoreWebView2Deferral struct {
+	vtbl *_ICoreWebView2DeferralVtbl
+}
+
+func (i *ICoreWebView2Deferral) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Deferral) Complete() error {
+	res, _, err := i.vtbl.Complete.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DownloadOperationVtbl struct {
+	_IUnknownVtbl
+	AddBytesReceivedChanged       ComProc
+	RemoveBytesReceivedChanged    ComProc
+	AddEstimatedEndTimeChanged    ComProc
+	RemoveEstimatedEndTimeChanged ComProc
+	AddStateChanged               ComProc
+	RemoveStateChanged            ComProc
+	GetUri                        ComProc
+	GetContentDisposition         ComProc
+	GetMimeType                   ComProc
+	GetTotalBytesToReceive        ComProc
+	GetBytesReceived              ComProc
+	GetEstimatedEndTime           ComProc
+	GetResultFilePath             ComProc
+	GetState                      ComProc
+	GetInterruptReason            ComProc
+	Cancel                        ComProc
+	Pause                         ComProc
+	Resume                        ComProc
+	GetCanResume                  ComProc
+}
+
+type ICoreWebView2DownloadOperation struct {
+	vtbl *_ICoreWebView2DownloadOperationVtbl
+}
+
+func (i *ICoreWebView2DownloadOperation) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2DownloadOperation) AddBytesReceivedChanged(eventHandler *ICoreWebView2BytesReceivedChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddBytesReceivedChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) RemoveBytesReceivedChanged(token _EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.RemoveBytesReceivedChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(token.value),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) AddStateChanged(eventHandler *ICoreWebView2StateChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddStateChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) RemoveStateChanged(token _EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.RemoveStateChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(token.value),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2DownloadOperation) GetUri() (string, error) {
+	// Create *uint16 to hold result
+	var _uri *uint16
+	res, _, err := i.vtbl.GetUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetContentDisposition() (string, error) {
+	// Create *uint16 to hold result
+	var _contentDisposition *uint16
+	res, _, err := i.vtbl.GetContentDisposition.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_contentDisposition)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	contentDisposition := windows.UTF16PtrToString(_contentDisposition)
+	windows.CoTaskMemFree(unsafe.Pointer(_contentDisposition))
+	return contentDisposition, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetMimeType() (string, error) {
+	// Create *uint16 to hold result
+	var _mimeType *uint16
+	res, _, err := i.vtbl.GetMimeType.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_mimeType)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	mimeType := windows.UTF16PtrToString(_mimeType)
+	windows.CoTaskMemFree(unsafe.Pointer(_mimeType))
+	return mimeType, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetTotalBytesToReceive() (int64, error) {
+	var totalBytesToReceive int64
+	res, _, err := i.vtbl.GetTotalBytesToReceive.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&totalBytesToReceive)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return totalBytesToReceive, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetBytesReceived() (int64, error) {
+	var bytesReceived int64
+	res, _, err := i.vtbl.GetBytesReceived.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&bytesReceived)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return bytesReceived, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetEstimatedEndTime() (string, error) {
+	// Create *uint16 to hold result
+	var _estimatedEndTime *uint16
+	res, _, err := i.vtbl.GetEstimatedEndTime.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_estimatedEndTime)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	estimatedEndTime := windows.UTF16PtrToString(_estimatedEndTime)
+	windows.CoTaskMemFree(unsafe.Pointer(_estimatedEndTime))
+	return estimatedEndTime, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetResultFilePath() (string, error) {
+	// Create *uint16 to hold result
+	var _resultFilePath *uint16
+	res, _, err := i.vtbl.GetResultFilePath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_resultFilePath)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	resultFilePath := windows.UTF16PtrToString(_resultFilePath)
+	windows.CoTaskMemFree(unsafe.Pointer(_resultFilePath))
+	return resultFilePath, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetState() (COREWEBVIEW2_DOWNLOAD_STATE, error) {
+	var state COREWEBVIEW2_DOWNLOAD_STATE
+	res, _, err := i.vtbl.GetState.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&state)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return state, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetInterruptReason() (COREWEBVIEW2_DOWNLOAD_INTERRUPT_REASON, error) {
+	var interruptReason COREWEBVIEW2_DOWNLOAD_INTERRUPT_REASON
+	res, _, err := i.vtbl.GetInterruptReason.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&interruptReason)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return interruptReason, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) Cancel() error {
+	res, _, err := i.vtbl.Cancel.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) Pause() error {
+	res, _, err := i.vtbl.Pause.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) Resume() error {
+	res, _, err := i.vtbl.Resume.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetCanResume() (bool, error) {
+	var canResume int32
+	res, _, err := i.vtbl.GetCanResume.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&canResume)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return canResume != 0, nil
+}
@@ -0,0 +1,133 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DownloadStartingEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetDownloadOperation ComProc
+	GetCancel            ComProc
+	PutCancel            ComProc
+	GetResultFilePath    ComProc
+	PutResultFilePath    ComProc
+	GetHandled           ComProc
+	PutHandled           ComProc
+	GetDeferral          ComProc
+}
+
+type ICoreWebView2DownloadStartingEventArgs struct {
+	vtbl *_ICoreWebView2DownloadStartingEventArgsVtbl
+}
+
+// AddRef must be called if the args are used after the event handler has returned, e.g. when completing a
+// deferral asynchronously.
+func (i *ICoreWebView2DownloadStartingEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) GetDownloadOperation() (*ICoreWebView2DownloadOperation, error) {
+	var downloadOperation *ICoreWebView2DownloadOperation
+	res, _, err := i.vtbl.GetDownloadOperation.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&downloadOperation)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return downloadOperation, nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) PutCancel(cancel bool) error {
+	res, _, err := i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) GetResultFilePath() (string, error) {
+	// Create *uint16 to hold result
+	var _resultFilePath *uint16
+	res, _, err := i.vtbl.GetResultFilePath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_resultFilePath)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	resultFilePath := windows.UTF16PtrToString(_resultFilePath)
+	windows.CoTaskMemFree(unsafe.Pointer(_resultFilePath))
+	return resultFilePath, nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) PutResultFilePath(resultFilePath string) error {
+	_resultFilePath, err := windows.UTF16PtrFromString(resultFilePath)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutResultFilePath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_resultFilePath)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) PutHandled(handled bool) error {
+	res, _, err := i.vtbl.PutHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+	res, _, err := i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return deferral, nil
+}
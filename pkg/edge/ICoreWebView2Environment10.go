@@ -0,0 +1,70 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Environment10Vtbl struct {
+	iCoreWebView2Environment9Vtbl
+	CreateCoreWebView2ControllerOptions                ComProc
+	CreateCoreWebView2ControllerWithOptions            ComProc
+	CreateCoreWebView2CompositionControllerWithOptions ComProc
+}
+
+type ICoreWebView2Environment10 struct {
+	vtbl *iCoreWebView2Environment10Vtbl
+}
+
+func (i *ICoreWebView2Environment10) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment10() *ICoreWebView2Environment10 {
+	var result *ICoreWebView2Environment10
+
+	iidICoreWebView2Environment10 := NewGUID("{ee0eb9df-6f12-46ce-b53f-3f47b9c928e0}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment10)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+// CreateCoreWebView2ControllerOptions creates options to select the profile of a controller, they must be released
+// after finishing using them.
+func (e *ICoreWebView2Environment10) CreateCoreWebView2ControllerOptions() (*ICoreWebView2ControllerOptions, error) {
+	var options *ICoreWebView2ControllerOptions
+	res, _, err := e.vtbl.CreateCoreWebView2ControllerOptions.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(&options)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return options, nil
+}
+
+func (e *ICoreWebView2Environment10) CreateCoreWebView2ControllerWithOptions(parentWindow uintptr, options *ICoreWebView2ControllerOptions, handler *iCoreWebView2CreateCoreWebView2ControllerCompletedHandler) error {
+	res, _, err := e.vtbl.CreateCoreWebView2ControllerWithOptions.Call(
+		uintptr(unsafe.Pointer(e)),
+		parentWindow,
+		uintptr(unsafe.Pointer(options)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+)
+
+type iCoreWebView2Environment11Vtbl struct {
+	iCoreWebView2Environment10Vtbl
+	GetFailureReportFolderPath ComProc
+}
+
+type ICoreWebView2Environment11 struct {
+	vtbl *iCoreWebView2Environment11Vtbl
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment11() *ICoreWebView2Environment11 {
+	var result *ICoreWebView2Environment11
+
+	iidICoreWebView2Environment11 := NewGUID("{F0913DC6-A0EC-42EF-9805-91DFF3A2966A}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment11)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
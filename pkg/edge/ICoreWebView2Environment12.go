@@ -0,0 +1,65 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Environment12Vtbl struct {
+	iCoreWebView2Environment11Vtbl
+	CreateSharedBuffer ComProc
+}
+
+type ICoreWebView2Environment12 struct {
+	vtbl *iCoreWebView2Environment12Vtbl
+}
+
+func (i *ICoreWebView2Environment12) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment12() *ICoreWebView2Environment12 {
+	var result *ICoreWebView2Environment12
+
+	iidICoreWebView2Environment12 := NewGUID("{F503DB9B-739F-48DD-B151-FDFCF253F54E}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment12)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+// CreateSharedBuffer creates a buffer of size bytes which can be shared with scripts, it must be released after
+// finishing using it.
+func (e *ICoreWebView2Environment12) CreateSharedBuffer(size uint64) (*ICoreWebView2SharedBuffer, error) {
+	var sharedBuffer *ICoreWebView2SharedBuffer
+	var res uintptr
+	var err error
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		res, _, err = e.vtbl.CreateSharedBuffer.Call(
+			uintptr(unsafe.Pointer(e)),
+			uintptr(size),
+			uintptr(unsafe.Pointer(&sharedBuffer)),
+		)
+	} else {
+		// The UINT64 is passed as two 32bit words on the stack.
+		res, _, err = e.vtbl.CreateSharedBuffer.Call(
+			uintptr(unsafe.Pointer(e)),
+			uintptr(size),
+			uintptr(size>>32),
+			uintptr(unsafe.Pointer(&sharedBuffer)),
+		)
+	}
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return sharedBuffer, nil
+}
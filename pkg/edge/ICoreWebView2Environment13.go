@@ -0,0 +1,51 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Environment13Vtbl struct {
+	iCoreWebView2Environment12Vtbl
+	GetProcessExtendedInfos ComProc
+}
+
+type ICoreWebView2Environment13 struct {
+	vtbl *iCoreWebView2Environment13Vtbl
+}
+
+func (i *ICoreWebView2Environment13) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment13() *ICoreWebView2Environment13 {
+	var result *ICoreWebView2Environment13
+
+	iidICoreWebView2Environment13 := NewGUID("{AF641F58-72B2-11EE-B962-0242AC120002}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment13)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+// GetProcessExtendedInfos reports the processes of the browser together with the frames each renderer process
+// renders.
+func (e *ICoreWebView2Environment13) GetProcessExtendedInfos(handler *ICoreWebView2GetProcessExtendedInfosCompletedHandler) error {
+	res, _, err := e.vtbl.GetProcessExtendedInfos.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+)
+
+type iCoreWebView2Environment2Vtbl struct {
+	iCoreWebView2EnvironmentVtbl
+	CreateWebResourceRequest ComProc
+}
+
+type ICoreWebView2Environment2 struct {
+	vtbl *iCoreWebView2Environment2Vtbl
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment2() *ICoreWebView2Environment2 {
+	var result *ICoreWebView2Environment2
+
+	iidICoreWebView2Environment2 := NewGUID("{41F3632B-5EF4-404F-AD82-2D606C5A9A21}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment2)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
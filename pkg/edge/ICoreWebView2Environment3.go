@@ -0,0 +1,29 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+)
+
+type iCoreWebView2Environment3Vtbl struct {
+	iCoreWebView2Environment2Vtbl
+	CreateCoreWebView2CompositionController ComProc
+	CreateCoreWebView2PointerInfo           ComProc
+}
+
+type ICoreWebView2Environment3 struct {
+	vtbl *iCoreWebView2Environment3Vtbl
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment3() *ICoreWebView2Environment3 {
+	var result *ICoreWebView2Environment3
+
+	iidICoreWebView2Environment3 := NewGUID("{80a22ae3-be7c-4ce2-afe1-5a50056cdeeb}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment3)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
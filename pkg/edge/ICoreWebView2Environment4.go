@@ -0,0 +1,28 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+)
+
+type iCoreWebView2Environment4Vtbl struct {
+	iCoreWebView2Environment3Vtbl
+	GetAutomationProviderForWindow ComProc
+}
+
+type ICoreWebView2Environment4 struct {
+	vtbl *iCoreWebView2Environment4Vtbl
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment4() *ICoreWebView2Environment4 {
+	var result *ICoreWebView2Environment4
+
+	iidICoreWebView2Environment4 := NewGUID("{20944379-6dcf-41d6-a0a0-abc0fc50de0d}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment4)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
@@ -0,0 +1,29 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+)
+
+type iCoreWebView2Environment5Vtbl struct {
+	iCoreWebView2Environment4Vtbl
+	AddBrowserProcessExited    ComProc
+	RemoveBrowserProcessExited ComProc
+}
+
+type ICoreWebView2Environment5 struct {
+	vtbl *iCoreWebView2Environment5Vtbl
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment5() *ICoreWebView2Environment5 {
+	var result *ICoreWebView2Environment5
+
+	iidICoreWebView2Environment5 := NewGUID("{319e423d-e0d7-4b8d-9254-ae9475de9b17}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment5)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
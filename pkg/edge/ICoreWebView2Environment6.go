@@ -0,0 +1,52 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Environment6Vtbl struct {
+	iCoreWebView2Environment5Vtbl
+	CreatePrintSettings ComProc
+}
+
+type ICoreWebView2Environment6 struct {
+	vtbl *iCoreWebView2Environment6Vtbl
+}
+
+func (i *ICoreWebView2Environment6) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment6() *ICoreWebView2Environment6 {
+	var result *ICoreWebView2Environment6
+
+	iidICoreWebView2Environment6 := NewGUID("{e59ee362-acbd-4857-9a8e-d3644d9459a9}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment6)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+// CreatePrintSettings creates a new ICoreWebView2PrintSettings with default values, it must be released after
+// finishing using it.
+func (e *ICoreWebView2Environment6) CreatePrintSettings() (*ICoreWebView2PrintSettings, error) {
+	var printSettings *ICoreWebView2PrintSettings
+	res, _, err := e.vtbl.CreatePrintSettings.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(&printSettings)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return printSettings, nil
+}
@@ -0,0 +1,50 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Environment7Vtbl struct {
+	iCoreWebView2Environment6Vtbl
+	GetUserDataFolder ComProc
+}
+
+type ICoreWebView2Environment7 struct {
+	vtbl *iCoreWebView2Environment7Vtbl
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment7() *ICoreWebView2Environment7 {
+	var result *ICoreWebView2Environment7
+
+	iidICoreWebView2Environment7 := NewGUID("{43C22296-3BBD-43A4-9C00-5C0DF6DD29A2}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment7)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *ICoreWebView2Environment7) GetUserDataFolder() (string, error) {
+	// Create *uint16 to hold result
+	var _userDataFolder *uint16
+	res, _, err := e.vtbl.GetUserDataFolder.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(&_userDataFolder)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	userDataFolder := windows.UTF16PtrToString(_userDataFolder)
+	windows.CoTaskMemFree(unsafe.Pointer(_userDataFolder))
+	return userDataFolder, nil
+}
@@ -0,0 +1,66 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Environment8Vtbl struct {
+	iCoreWebView2Environment7Vtbl
+	AddProcessInfosChanged    ComProc
+	RemoveProcessInfosChanged ComProc
+	GetProcessInfos           ComProc
+}
+
+type ICoreWebView2Environment8 struct {
+	vtbl *iCoreWebView2Environment8Vtbl
+}
+
+func (i *ICoreWebView2Environment8) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Environment8) AddProcessInfosChanged(eventHandler *ICoreWebView2ProcessInfosChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddProcessInfosChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment8() *ICoreWebView2Environment8 {
+	var result *ICoreWebView2Environment8
+
+	iidICoreWebView2Environment8 := NewGUID("{D6EB91DD-C3D2-45E5-BD29-6DC2BC4DE9CF}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment8)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+// GetProcessInfos returns the processes of the browser, it must be released after finishing using it.
+func (e *ICoreWebView2Environment8) GetProcessInfos() (*ICoreWebView2ProcessInfoCollection, error) {
+	var processInfos *ICoreWebView2ProcessInfoCollection
+	res, _, err := e.vtbl.GetProcessInfos.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(&processInfos)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return processInfos, nil
+}
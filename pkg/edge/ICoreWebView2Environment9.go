@@ -0,0 +1,60 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Environment9Vtbl struct {
+	iCoreWebView2Environment8Vtbl
+	CreateContextMenuItem ComProc
+}
+
+type ICoreWebView2Environment9 struct {
+	vtbl *iCoreWebView2Environment9Vtbl
+}
+
+func (i *ICoreWebView2Environment9) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment9() *ICoreWebView2Environment9 {
+	var result *ICoreWebView2Environment9
+
+	iidICoreWebView2Environment9 := NewGUID("{f06f41bf-4b5a-49d8-b9f6-fa16cd29f274}")
+	_, _, _ = e.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Environment9)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+// CreateContextMenuItem creates a custom context menu item which can be inserted into the menu items of a
+// ContextMenuRequested event. iconStream may be nil, the returned item must be released after finishing using it.
+func (e *ICoreWebView2Environment9) CreateContextMenuItem(label string, iconStream *IStream, kind COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND) (*ICoreWebView2ContextMenuItem, error) {
+	_label, err := windows.UTF16PtrFromString(label)
+	if err != nil {
+		return nil, err
+	}
+
+	var item *ICoreWebView2ContextMenuItem
+	res, _, err := e.vtbl.CreateContextMenuItem.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(_label)),
+		uintptr(unsafe.Pointer(iconStream)),
+		uintptr(kind),
+		uintptr(unsafe.Pointer(&item)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return item, nil
+}
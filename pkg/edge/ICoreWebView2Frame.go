@@ -0,0 +1,109 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2FrameVtbl struct {
+	_IUnknownVtbl
+	GetName                          ComProc
+	AddNameChanged                   ComProc
+	RemoveNameChanged                ComProc
+	AddHostObjectToScriptWithOrigins ComProc
+	RemoveHostObjectFromScript       ComProc
+	AddDestroyed                     ComProc
+	RemoveDestroyed                  ComProc
+	IsDestroyed                      ComProc
+}
+
+// ICoreWebView2Frame is an iframe of the top level document.
+type ICoreWebView2Frame struct {
+	vtbl *iCoreWebView2FrameVtbl
+}
+
+func (i *ICoreWebView2Frame) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+// IsDestroyed reports if the frame has been removed from the document.
+func (i *ICoreWebView2Frame) IsDestroyed() (bool, error) {
+	var destroyed int32
+	res, _, err := i.vtbl.IsDestroyed.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&destroyed)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return destroyed != 0, nil
+}
+
+func (i *ICoreWebView2Frame) GetICoreWebView2Frame2() *ICoreWebView2Frame2 {
+	var result *ICoreWebView2Frame2
+
+	iidICoreWebView2Frame2 := NewGUID("{7a6a5834-d185-4dbf-b63f-4a9bc43107d4}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Frame2)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (i *ICoreWebView2Frame) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Frame) GetName() (string, error) {
+	// Create *uint16 to hold result
+	var _name *uint16
+	res, _, err := i.vtbl.GetName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_name)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	name := windows.UTF16PtrToString(_name)
+	windows.CoTaskMemFree(unsafe.Pointer(_name))
+	return name, nil
+}
+
+func (i *ICoreWebView2Frame) AddNameChanged(eventHandler *ICoreWebView2FrameNameChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddNameChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Frame) AddDestroyed(eventHandler *ICoreWebView2FrameDestroyedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddDestroyed.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Frame2Vtbl struct {
+	iCoreWebView2FrameVtbl
+	AddNavigationStarting     ComProc
+	RemoveNavigationStarting  ComProc
+	AddContentLoading         ComProc
+	RemoveContentLoading      ComProc
+	AddNavigationCompleted    ComProc
+	RemoveNavigationCompleted ComProc
+	AddDOMContentLoaded       ComProc
+	RemoveDOMContentLoaded    ComProc
+	ExecuteScript             ComProc
+	PostWebMessageAsJSON      ComProc
+	PostWebMessageAsString    ComProc
+	AddWebMessageReceived     ComProc
+	RemoveWebMessageReceived  ComProc
+}
+
+type ICoreWebView2Frame2 struct {
+	vtbl *iCoreWebView2Frame2Vtbl
+}
+
+func (i *ICoreWebView2Frame2) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Frame2) AddNavigationStarting(eventHandler *ICoreWebView2FrameNavigationStartingEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddNavigationStarting.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Frame2) AddNavigationCompleted(eventHandler *ICoreWebView2FrameNavigationCompletedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddNavigationCompleted.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Frame2) AddWebMessageReceived(eventHandler *ICoreWebView2FrameWebMessageReceivedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddWebMessageReceived.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+// ExecuteScript runs script in the frame, handler may be nil if the result isn't needed.
+func (i *ICoreWebView2Frame2) ExecuteScript(script string, handler *ICoreWebView2ExecuteScriptCompletedHandler) error {
+	_script, err := windows.UTF16PtrFromString(script)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.ExecuteScript.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_script)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Frame2) PostWebMessageAsJSON(webMessageAsJSON string) error {
+	_webMessageAsJSON, err := windows.UTF16PtrFromString(webMessageAsJSON)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PostWebMessageAsJSON.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_webMessageAsJSON)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Frame2) PostWebMessageAsString(webMessageAsString string) error {
+	_webMessageAsString, err := windows.UTF16PtrFromString(webMessageAsString)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PostWebMessageAsString.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_webMessageAsString)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
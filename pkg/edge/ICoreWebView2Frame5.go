@@ -0,0 +1,61 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Frame3Vtbl struct {
+	iCoreWebView2Frame2Vtbl
+	AddPermissionRequested    ComProc
+	RemovePermissionRequested ComProc
+}
+
+type iCoreWebView2Frame4Vtbl struct {
+	iCoreWebView2Frame3Vtbl
+	PostSharedBufferToScript ComProc
+}
+
+type iCoreWebView2Frame5Vtbl struct {
+	iCoreWebView2Frame4Vtbl
+	GetFrameId ComProc
+}
+
+type ICoreWebView2Frame5 struct {
+	vtbl *iCoreWebView2Frame5Vtbl
+}
+
+func (i *ICoreWebView2Frame) GetICoreWebView2Frame5() *ICoreWebView2Frame5 {
+	var result *ICoreWebView2Frame5
+
+	iidICoreWebView2Frame5 := NewGUID("{99D199C4-7305-11EE-B962-0242AC120002}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Frame5)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (i *ICoreWebView2Frame5) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Frame5) GetFrameId() (uint32, error) {
+	var frameId uint32
+	res, _, err := i.vtbl.GetFrameId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&frameId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return frameId, nil
+}
@@ -0,0 +1,34 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2FrameCreatedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetFrame ComProc
+}
+
+type ICoreWebView2FrameCreatedEventArgs struct {
+	vtbl *_ICoreWebView2FrameCreatedEventArgsVtbl
+}
+
+func (i *ICoreWebView2FrameCreatedEventArgs) GetFrame() (*ICoreWebView2Frame, error) {
+	var frame *ICoreWebView2Frame
+	res, _, err := i.vtbl.GetFrame.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&frame)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return frame, nil
+}
@@ -0,0 +1,106 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2FrameInfoVtbl struct {
+	_IUnknownVtbl
+	GetName   ComProc
+	GetSource ComProc
+}
+
+type ICoreWebView2FrameInfo struct {
+	vtbl *_ICoreWebView2FrameInfoVtbl
+}
+
+func (i *ICoreWebView2FrameInfo) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2FrameInfo) GetName() (string, error) {
+	// Create *uint16 to hold result
+	var _name *uint16
+	res, _, err := i.vtbl.GetName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_name)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	name := windows.UTF16PtrToString(_name)
+	windows.CoTaskMemFree(unsafe.Pointer(_name))
+	return name, nil
+}
+
+func (i *ICoreWebView2FrameInfo) GetSource() (string, error) {
+	// Create *uint16 to hold result
+	var _source *uint16
+	res, _, err := i.vtbl.GetSource.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_source)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	source := windows.UTF16PtrToString(_source)
+	windows.CoTaskMemFree(unsafe.Pointer(_source))
+	return source, nil
+}
+
+type _ICoreWebView2FrameInfo2Vtbl struct {
+	_ICoreWebView2FrameInfoVtbl
+	GetParentFrameInfo ComProc
+	GetFrameId         ComProc
+	GetFrameKind       ComProc
+}
+
+type ICoreWebView2FrameInfo2 struct {
+	vtbl *_ICoreWebView2FrameInfo2Vtbl
+}
+
+// GetICoreWebView2FrameInfo2 returns nil if the runtime doesn't support it, the result must be released after
+// finishing using it.
+func (i *ICoreWebView2FrameInfo) GetICoreWebView2FrameInfo2() *ICoreWebView2FrameInfo2 {
+	var result *ICoreWebView2FrameInfo2
+
+	iidICoreWebView2FrameInfo2 := NewGUID("{56F85CFA-72C4-11EE-B962-0242AC120002}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2FrameInfo2)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (i *ICoreWebView2FrameInfo2) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2FrameInfo2) GetFrameId() (uint32, error) {
+	var frameId uint32
+	res, _, err := i.vtbl.GetFrameId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&frameId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return frameId, nil
+}
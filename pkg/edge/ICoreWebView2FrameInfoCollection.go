@@ -0,0 +1,39 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2FrameInfoCollectionVtbl struct {
+	_IUnknownVtbl
+	GetIterator ComProc
+}
+
+type ICoreWebView2FrameInfoCollection struct {
+	vtbl *_ICoreWebView2FrameInfoCollectionVtbl
+}
+
+func (i *ICoreWebView2FrameInfoCollection) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+// GetIterator returns an iterator over the frame infos, it must be released after finishing using it.
+func (i *ICoreWebView2FrameInfoCollection) GetIterator() (*ICoreWebView2FrameInfoCollectionIterator, error) {
+	var iterator *ICoreWebView2FrameInfoCollectionIterator
+	res, _, err := i.vtbl.GetIterator.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&iterator)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return iterator, nil
+}
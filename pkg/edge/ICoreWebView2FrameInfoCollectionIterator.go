@@ -0,0 +1,72 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2FrameInfoCollectionIteratorVtbl struct {
+	_IUnknownVtbl
+	GetHasCurrent ComProc
+	GetCurrent    ComProc
+	MoveNext      ComProc
+}
+
+type ICoreWebView2FrameInfoCollectionIterator struct {
+	vtbl *_ICoreWebView2FrameInfoCollectionIteratorVtbl
+}
+
+func (i *ICoreWebView2FrameInfoCollectionIterator) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2FrameInfoCollectionIterator) GetHasCurrent() (bool, error) {
+	var hasCurrent int32
+	res, _, err := i.vtbl.GetHasCurrent.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hasCurrent)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return hasCurrent != 0, nil
+}
+
+// GetCurrent returns the current frame info, it must be released after finishing using it.
+func (i *ICoreWebView2FrameInfoCollectionIterator) GetCurrent() (*ICoreWebView2FrameInfo, error) {
+	var current *ICoreWebView2FrameInfo
+	res, _, err := i.vtbl.GetCurrent.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&current)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return current, nil
+}
+
+// MoveNext advances to the next frame info and reports whether there is one.
+func (i *ICoreWebView2FrameInfoCollectionIterator) MoveNext() (bool, error) {
+	var hasNext int32
+	res, _, err := i.vtbl.MoveNext.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hasNext)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return hasNext != 0, nil
+}
@@ -0,0 +1,53 @@
+//go:build windows
+
+package edge
+
+type _ICoreWebView2GetProcessExtendedInfosCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type ICoreWebView2GetProcessExtendedInfosCompletedHandler struct {
+	vtbl *_ICoreWebView2GetProcessExtendedInfosCompletedHandlerVtbl
+	impl _ICoreWebView2GetProcessExtendedInfosCompletedHandlerImpl
+}
+
+func (i *ICoreWebView2GetProcessExtendedInfosCompletedHandler) AddRef() uintptr {
+	return i.AddRef()
+}
+func _ICoreWebView2GetProcessExtendedInfosCompletedHandlerIUnknownQueryInterface(this *ICoreWebView2GetProcessExtendedInfosCompletedHandler, refiid, object uintptr) uintptr {
+	return this.impl.QueryInterface(refiid, object)
+}
+
+func _ICoreWebView2GetProcessExtendedInfosCompletedHandlerIUnknownAddRef(this *ICoreWebView2GetProcessExtendedInfosCompletedHandler) uintptr {
+	return this.impl.AddRef()
+}
+
+func _ICoreWebView2GetProcessExtendedInfosCompletedHandlerIUnknownRelease(this *ICoreWebView2GetProcessExtendedInfosCompletedHandler) uintptr {
+	return this.impl.Release()
+}
+
+func _ICoreWebView2GetProcessExtendedInfosCompletedHandlerInvoke(this *ICoreWebView2GetProcessExtendedInfosCompletedHandler, errorCode uintptr, result *ICoreWebView2ProcessExtendedInfoCollection) uintptr {
+	return this.impl.GetProcessExtendedInfosCompleted(errorCode, result)
+}
+
+type _ICoreWebView2GetProcessExtendedInfosCompletedHandlerImpl interface {
+	_IUnknownImpl
+	GetProcessExtendedInfosCompleted(errorCode uintptr, result *ICoreWebView2ProcessExtendedInfoCollection) uintptr
+}
+
+var _ICoreWebView2GetProcessExtendedInfosCompletedHandlerFn = _ICoreWebView2GetProcessExtendedInfosCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2GetProcessExtendedInfosCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2GetProcessExtendedInfosCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2GetProcessExtendedInfosCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2GetProcessExtendedInfosCompletedHandlerInvoke),
+}
+
+func newICoreWebView2GetProcessExtendedInfosCompletedHandler(impl _ICoreWebView2GetProcessExtendedInfosCompletedHandlerImpl) *ICoreWebView2GetProcessExtendedInfosCompletedHandler {
+	return &ICoreWebView2GetProcessExtendedInfosCompletedHandler{
+		vtbl: &_ICoreWebView2GetProcessExtendedInfosCompletedHandlerFn,
+		impl: impl,
+	}
+}
@@ -0,0 +1,132 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2LaunchingExternalUriSchemeEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri              ComProc
+	GetInitiatingOrigin ComProc
+	GetIsUserInitiated  ComProc
+	GetCancel           ComProc
+	PutCancel           ComProc
+	GetDeferral         ComProc
+}
+
+type ICoreWebView2LaunchingExternalUriSchemeEventArgs struct {
+	vtbl *_ICoreWebView2LaunchingExternalUriSchemeEventArgsVtbl
+}
+
+// AddRef must be called if the args are used after the event handler has returned, e.g. when completing a
+// deferral asynchronously.
+func (i *ICoreWebView2LaunchingExternalUriSchemeEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2LaunchingExternalUriSchemeEventArgs) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2LaunchingExternalUriSchemeEventArgs) GetUri() (string, error) {
+	// Create *uint16 to hold result
+	var _uri *uint16
+	res, _, err := i.vtbl.GetUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2LaunchingExternalUriSchemeEventArgs) GetInitiatingOrigin() (string, error) {
+	// Create *uint16 to hold result
+	var _initiatingOrigin *uint16
+	res, _, err := i.vtbl.GetInitiatingOrigin.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_initiatingOrigin)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	initiatingOrigin := windows.UTF16PtrToString(_initiatingOrigin)
+	windows.CoTaskMemFree(unsafe.Pointer(_initiatingOrigin))
+	return initiatingOrigin, nil
+}
+
+func (i *ICoreWebView2LaunchingExternalUriSchemeEventArgs) GetIsUserInitiated() (bool, error) {
+	var isUserInitiated int32
+	res, _, err := i.vtbl.GetIsUserInitiated.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isUserInitiated)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isUserInitiated != 0, nil
+}
+
+func (i *ICoreWebView2LaunchingExternalUriSchemeEventArgs) GetCancel() (bool, error) {
+	var cancel int32
+	res, _, err := i.vtbl.GetCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return cancel != 0, nil
+}
+
+func (i *ICoreWebView2LaunchingExternalUriSchemeEventArgs) PutCancel(cancel bool) error {
+	res, _, err := i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2LaunchingExternalUriSchemeEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+	res, _, err := i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return deferral, nil
+}
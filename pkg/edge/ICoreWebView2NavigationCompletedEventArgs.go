@@ -2,6 +2,13 @@
 
 package edge
 
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
 type _ICoreWebView2NavigationCompletedEventArgsVtbl struct {
 	_IUnknownVtbl
 	GetIsSuccess      ComProc
@@ -16,3 +23,90 @@ type ICoreWebView2NavigationCompletedEventArgs struct {
 func (i *ICoreWebView2NavigationCompletedEventArgs) AddRef() uintptr {
 	return i.AddRef()
 }
+
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetIsSuccess() (bool, error) {
+	var isSuccess int32
+	res, _, err := i.vtbl.GetIsSuccess.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isSuccess)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isSuccess != 0, nil
+}
+
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetWebErrorStatus() (COREWEBVIEW2_WEB_ERROR_STATUS, error) {
+	var webErrorStatus COREWEBVIEW2_WEB_ERROR_STATUS
+	res, _, err := i.vtbl.GetWebErrorStatus.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&webErrorStatus)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return webErrorStatus, nil
+}
+
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetNavigationId() (uint64, error) {
+	var navigationId uint64
+	res, _, err := i.vtbl.GetNavigationId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&navigationId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return navigationId, nil
+}
+
+// GetICoreWebView2NavigationCompletedEventArgs2 returns the ICoreWebView2NavigationCompletedEventArgs2 interface
+// or nil if the runtime doesn't support it. Make sure to call Release on the returned Object after finished using it.
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetICoreWebView2NavigationCompletedEventArgs2() *ICoreWebView2NavigationCompletedEventArgs2 {
+	var result *ICoreWebView2NavigationCompletedEventArgs2
+
+	iidICoreWebView2NavigationCompletedEventArgs2 := NewGUID("{FDF8B738-EE1E-4DB2-A329-8D7D7B74D792}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2NavigationCompletedEventArgs2)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+type _ICoreWebView2NavigationCompletedEventArgs2Vtbl struct {
+	_ICoreWebView2NavigationCompletedEventArgsVtbl
+	GetHttpStatusCode ComProc
+}
+
+type ICoreWebView2NavigationCompletedEventArgs2 struct {
+	vtbl *_ICoreWebView2NavigationCompletedEventArgs2Vtbl
+}
+
+func (i *ICoreWebView2NavigationCompletedEventArgs2) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2NavigationCompletedEventArgs2) GetHttpStatusCode() (int, error) {
+	var httpStatusCode int32
+	res, _, err := i.vtbl.GetHttpStatusCode.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&httpStatusCode)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return int(httpStatusCode), nil
+}
@@ -0,0 +1,124 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2NavigationStartingEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri             ComProc
+	GetIsUserInitiated ComProc
+	GetIsRedirected    ComProc
+	GetRequestHeaders  ComProc
+	GetCancel          ComProc
+	PutCancel          ComProc
+	GetNavigationId    ComProc
+}
+
+type ICoreWebView2NavigationStartingEventArgs struct {
+	vtbl *_ICoreWebView2NavigationStartingEventArgsVtbl
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) AddRef() uintptr {
+	return i.AddRef()
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetUri() (string, error) {
+	// Create *uint16 to hold result
+	var _uri *uint16
+	res, _, err := i.vtbl.GetUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetIsUserInitiated() (bool, error) {
+	var isUserInitiated int32
+	res, _, err := i.vtbl.GetIsUserInitiated.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isUserInitiated)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isUserInitiated != 0, nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetIsRedirected() (bool, error) {
+	var isRedirected int32
+	res, _, err := i.vtbl.GetIsRedirected.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isRedirected)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isRedirected != 0, nil
+}
+
+// GetRequestHeaders returns the mutable HTTP request headers of the navigation. Make sure to call
+// Release on the returned Object after finished using it.
+func (i *ICoreWebView2NavigationStartingEventArgs) GetRequestHeaders() (*ICoreWebView2HttpRequestHeaders, error) {
+	var headers *ICoreWebView2HttpRequestHeaders
+	res, _, err := i.vtbl.GetRequestHeaders.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&headers)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return headers, nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) PutCancel(cancel bool) error {
+	res, _, err := i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetNavigationId() (uint64, error) {
+	var navigationId uint64
+	res, _, err := i.vtbl.GetNavigationId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&navigationId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return navigationId, nil
+}
@@ -0,0 +1,128 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2PermissionRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetURI             ComProc
+	GetPermissionKind  ComProc
+	GetIsUserInitiated ComProc
+	GetState           ComProc
+	PutState           ComProc
+	GetDeferral        ComProc
+}
+
+type ICoreWebView2PermissionRequestedEventArgs struct {
+	vtbl *_ICoreWebView2PermissionRequestedEventArgsVtbl
+}
+
+// AddRef must be called if the args are used after the event handler has returned, e.g. when completing a
+// deferral asynchronously.
+func (i *ICoreWebView2PermissionRequestedEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetURI() (string, error) {
+	// Create *uint16 to hold result
+	var _uRI *uint16
+	res, _, err := i.vtbl.GetURI.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uRI)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	uRI := windows.UTF16PtrToString(_uRI)
+	windows.CoTaskMemFree(unsafe.Pointer(_uRI))
+	return uRI, nil
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetPermissionKind() (CoreWebView2PermissionKind, error) {
+	var permissionKind CoreWebView2PermissionKind
+	res, _, err := i.vtbl.GetPermissionKind.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&permissionKind)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return permissionKind, nil
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetIsUserInitiated() (bool, error) {
+	var isUserInitiated int32
+	res, _, err := i.vtbl.GetIsUserInitiated.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isUserInitiated)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isUserInitiated != 0, nil
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetState() (CoreWebView2PermissionState, error) {
+	var state CoreWebView2PermissionState
+	res, _, err := i.vtbl.GetState.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&state)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return state, nil
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) PutState(state CoreWebView2PermissionState) error {
+	res, _, err := i.vtbl.PutState.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(state),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+	res, _, err := i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return deferral, nil
+}
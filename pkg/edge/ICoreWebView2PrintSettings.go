@@ -0,0 +1,349 @@
+//go:build windows
+
+package edge
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2PrintSettingsVtbl struct {
+	_IUnknownVtbl
+	GetOrientation                ComProc
+	PutOrientation                ComProc
+	GetScaleFactor                ComProc
+	PutScaleFactor                ComProc
+	GetPageWidth                  ComProc
+	PutPageWidth                  ComProc
+	GetPageHeight                 ComProc
+	PutPageHeight                 ComProc
+	GetMarginTop                  ComProc
+	PutMarginTop                  ComProc
+	GetMarginBottom               ComProc
+	PutMarginBottom               ComProc
+	GetMarginLeft                 ComProc
+	PutMarginLeft                 ComProc
+	GetMarginRight                ComProc
+	PutMarginRight                ComProc
+	GetShouldPrintBackgrounds     ComProc
+	PutShouldPrintBackgrounds     ComProc
+	GetShouldPrintSelectionOnly   ComProc
+	PutShouldPrintSelectionOnly   ComProc
+	GetShouldPrintHeaderAndFooter ComProc
+	PutShouldPrintHeaderAndFooter ComProc
+	GetHeaderTitle                ComProc
+	PutHeaderTitle                ComProc
+	GetFooterUri                  ComProc
+	PutFooterUri                  ComProc
+}
+
+type ICoreWebView2PrintSettings struct {
+	vtbl *_ICoreWebView2PrintSettingsVtbl
+}
+
+func (i *ICoreWebView2PrintSettings) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2PrintSettings) PutOrientation(orientation COREWEBVIEW2_PRINT_ORIENTATION) error {
+	res, _, err := i.vtbl.PutOrientation.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(orientation),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutScaleFactor(scaleFactor float64) error {
+	res, _, err := i.vtbl.PutScaleFactor.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(scaleFactor)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutPageWidth(pageWidth float64) error {
+	res, _, err := i.vtbl.PutPageWidth.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(pageWidth)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutPageHeight(pageHeight float64) error {
+	res, _, err := i.vtbl.PutPageHeight.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(pageHeight)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutMarginTop(marginTop float64) error {
+	res, _, err := i.vtbl.PutMarginTop.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(marginTop)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutMarginBottom(marginBottom float64) error {
+	res, _, err := i.vtbl.PutMarginBottom.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(marginBottom)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutMarginLeft(marginLeft float64) error {
+	res, _, err := i.vtbl.PutMarginLeft.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(marginLeft)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutMarginRight(marginRight float64) error {
+	res, _, err := i.vtbl.PutMarginRight.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(marginRight)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintBackgrounds(shouldPrintBackgrounds bool) error {
+	res, _, err := i.vtbl.PutShouldPrintBackgrounds.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(shouldPrintBackgrounds)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintSelectionOnly(shouldPrintSelectionOnly bool) error {
+	res, _, err := i.vtbl.PutShouldPrintSelectionOnly.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(shouldPrintSelectionOnly)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintHeaderAndFooter(shouldPrintHeaderAndFooter bool) error {
+	res, _, err := i.vtbl.PutShouldPrintHeaderAndFooter.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(shouldPrintHeaderAndFooter)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutHeaderTitle(headerTitle string) error {
+	_headerTitle, err := windows.UTF16PtrFromString(headerTitle)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutHeaderTitle.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_headerTitle)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutFooterUri(footerUri string) error {
+	_footerUri, err := windows.UTF16PtrFromString(footerUri)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutFooterUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_footerUri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+// GetICoreWebView2PrintSettings2 returns the ICoreWebView2PrintSettings2 interface or nil if the runtime doesn't
+// support it. Make sure to call Release on the returned Object after finished using it.
+func (i *ICoreWebView2PrintSettings) GetICoreWebView2PrintSettings2() *ICoreWebView2PrintSettings2 {
+	var result *ICoreWebView2PrintSettings2
+
+	iidICoreWebView2PrintSettings2 := NewGUID("{CA7F0E1F-3484-41D1-8C1A-65CD44A63F8D}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2PrintSettings2)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+type _ICoreWebView2PrintSettings2Vtbl struct {
+	_ICoreWebView2PrintSettingsVtbl
+	GetPageRanges   ComProc
+	PutPageRanges   ComProc
+	GetPagesPerSide ComProc
+	PutPagesPerSide ComProc
+	GetCopies       ComProc
+	PutCopies       ComProc
+	GetCollation    ComProc
+	PutCollation    ComProc
+	GetColorMode    ComProc
+	PutColorMode    ComProc
+	GetDuplex       ComProc
+	PutDuplex       ComProc
+	GetMediaSize    ComProc
+	PutMediaSize    ComProc
+	GetPrinterName  ComProc
+	PutPrinterName  ComProc
+}
+
+type ICoreWebView2PrintSettings2 struct {
+	vtbl *_ICoreWebView2PrintSettings2Vtbl
+}
+
+func (i *ICoreWebView2PrintSettings2) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2PrintSettings2) PutPageRanges(pageRanges string) error {
+	_pageRanges, err := windows.UTF16PtrFromString(pageRanges)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutPageRanges.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_pageRanges)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings2) PutPagesPerSide(pagesPerSide int32) error {
+	res, _, err := i.vtbl.PutPagesPerSide.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(pagesPerSide),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings2) PutCopies(copies int32) error {
+	res, _, err := i.vtbl.PutCopies.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(copies),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings2) PutPrinterName(printerName string) error {
+	_printerName, err := windows.UTF16PtrFromString(printerName)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutPrinterName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_printerName)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
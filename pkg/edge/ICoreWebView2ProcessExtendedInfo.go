@@ -0,0 +1,56 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ProcessExtendedInfoVtbl struct {
+	_IUnknownVtbl
+	GetProcessInfo          ComProc
+	GetAssociatedFrameInfos ComProc
+}
+
+type ICoreWebView2ProcessExtendedInfo struct {
+	vtbl *_ICoreWebView2ProcessExtendedInfoVtbl
+}
+
+func (i *ICoreWebView2ProcessExtendedInfo) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+// GetProcessInfo returns the process info, it must be released after finishing using it.
+func (i *ICoreWebView2ProcessExtendedInfo) GetProcessInfo() (*ICoreWebView2ProcessInfo, error) {
+	var processInfo *ICoreWebView2ProcessInfo
+	res, _, err := i.vtbl.GetProcessInfo.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&processInfo)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return processInfo, nil
+}
+
+// GetAssociatedFrameInfos returns the frames rendered by the process, it must be released after finishing using it.
+func (i *ICoreWebView2ProcessExtendedInfo) GetAssociatedFrameInfos() (*ICoreWebView2FrameInfoCollection, error) {
+	var associatedFrameInfos *ICoreWebView2FrameInfoCollection
+	res, _, err := i.vtbl.GetAssociatedFrameInfos.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&associatedFrameInfos)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return associatedFrameInfos, nil
+}
@@ -0,0 +1,56 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ProcessExtendedInfoCollectionVtbl struct {
+	_IUnknownVtbl
+	GetCount        ComProc
+	GetValueAtIndex ComProc
+}
+
+type ICoreWebView2ProcessExtendedInfoCollection struct {
+	vtbl *_ICoreWebView2ProcessExtendedInfoCollectionVtbl
+}
+
+func (i *ICoreWebView2ProcessExtendedInfoCollection) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ProcessExtendedInfoCollection) GetCount() (uint32, error) {
+	var count uint32
+	res, _, err := i.vtbl.GetCount.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&count)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return count, nil
+}
+
+// GetValueAtIndex returns the process info at the specified index, it must be released after finishing using it.
+func (i *ICoreWebView2ProcessExtendedInfoCollection) GetValueAtIndex(index uint32) (*ICoreWebView2ProcessExtendedInfo, error) {
+	var processExtendedInfo *ICoreWebView2ProcessExtendedInfo
+	res, _, err := i.vtbl.GetValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+		uintptr(unsafe.Pointer(&processExtendedInfo)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return processExtendedInfo, nil
+}
@@ -0,0 +1,54 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ProcessInfoVtbl struct {
+	_IUnknownVtbl
+	GetProcessId ComProc
+	GetKind      ComProc
+}
+
+type ICoreWebView2ProcessInfo struct {
+	vtbl *_ICoreWebView2ProcessInfoVtbl
+}
+
+func (i *ICoreWebView2ProcessInfo) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ProcessInfo) GetProcessId() (int32, error) {
+	var processId int32
+	res, _, err := i.vtbl.GetProcessId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&processId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return processId, nil
+}
+
+func (i *ICoreWebView2ProcessInfo) GetKind() (COREWEBVIEW2_PROCESS_KIND, error) {
+	var kind COREWEBVIEW2_PROCESS_KIND
+	res, _, err := i.vtbl.GetKind.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&kind)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return kind, nil
+}
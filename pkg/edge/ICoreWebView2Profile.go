@@ -0,0 +1,227 @@
+//go:build windows
+
+package edge
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2ProfileVtbl struct {
+	_IUnknownVtbl
+	GetProfileName               ComProc
+	GetIsInPrivateModeEnabled    ComProc
+	GetProfilePath               ComProc
+	GetDefaultDownloadFolderPath ComProc
+	PutDefaultDownloadFolderPath ComProc
+	GetPreferredColorScheme      ComProc
+	PutPreferredColorScheme      ComProc
+}
+
+type iCoreWebView2Profile2Vtbl struct {
+	iCoreWebView2ProfileVtbl
+	ClearBrowsingData            ComProc
+	ClearBrowsingDataInTimeRange ComProc
+	ClearBrowsingDataAll         ComProc
+}
+
+// ICoreWebView2Profile holds the settings and browsing data which are shared by all webviews using the same
+// profile.
+type ICoreWebView2Profile struct {
+	vtbl *iCoreWebView2ProfileVtbl
+}
+
+type ICoreWebView2Profile2 struct {
+	vtbl *iCoreWebView2Profile2Vtbl
+}
+
+func (i *ICoreWebView2Profile2) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Profile) GetICoreWebView2Profile2() *ICoreWebView2Profile2 {
+	var result *ICoreWebView2Profile2
+
+	iidICoreWebView2Profile2 := NewGUID("{fa740d4b-5eae-4344-a8ad-74be31925397}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2Profile2)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (i *ICoreWebView2Profile) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Profile) GetProfileName() (string, error) {
+	// Create *uint16 to hold result
+	var _profileName *uint16
+	res, _, err := i.vtbl.GetProfileName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_profileName)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	profileName := windows.UTF16PtrToString(_profileName)
+	windows.CoTaskMemFree(unsafe.Pointer(_profileName))
+	return profileName, nil
+}
+
+func (i *ICoreWebView2Profile) GetIsInPrivateModeEnabled() (bool, error) {
+	var isInPrivateModeEnabled int32
+	res, _, err := i.vtbl.GetIsInPrivateModeEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isInPrivateModeEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isInPrivateModeEnabled != 0, nil
+}
+
+func (i *ICoreWebView2Profile) GetProfilePath() (string, error) {
+	// Create *uint16 to hold result
+	var _profilePath *uint16
+	res, _, err := i.vtbl.GetProfilePath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_profilePath)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	profilePath := windows.UTF16PtrToString(_profilePath)
+	windows.CoTaskMemFree(unsafe.Pointer(_profilePath))
+	return profilePath, nil
+}
+
+func (i *ICoreWebView2Profile) GetDefaultDownloadFolderPath() (string, error) {
+	// Create *uint16 to hold result
+	var _defaultDownloadFolderPath *uint16
+	res, _, err := i.vtbl.GetDefaultDownloadFolderPath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_defaultDownloadFolderPath)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	defaultDownloadFolderPath := windows.UTF16PtrToString(_defaultDownloadFolderPath)
+	windows.CoTaskMemFree(unsafe.Pointer(_defaultDownloadFolderPath))
+	return defaultDownloadFolderPath, nil
+}
+
+func (i *ICoreWebView2Profile) PutDefaultDownloadFolderPath(defaultDownloadFolderPath string) error {
+	_defaultDownloadFolderPath, err := windows.UTF16PtrFromString(defaultDownloadFolderPath)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := i.vtbl.PutDefaultDownloadFolderPath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_defaultDownloadFolderPath)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Profile) GetPreferredColorScheme() (COREWEBVIEW2_PREFERRED_COLOR_SCHEME, error) {
+	var preferredColorScheme COREWEBVIEW2_PREFERRED_COLOR_SCHEME
+	res, _, err := i.vtbl.GetPreferredColorScheme.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&preferredColorScheme)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return preferredColorScheme, nil
+}
+
+func (i *ICoreWebView2Profile) PutPreferredColorScheme(preferredColorScheme COREWEBVIEW2_PREFERRED_COLOR_SCHEME) error {
+	res, _, err := i.vtbl.PutPreferredColorScheme.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(preferredColorScheme),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Profile2) ClearBrowsingData(dataKinds COREWEBVIEW2_BROWSING_DATA_KINDS, handler *ICoreWebView2ClearBrowsingDataCompletedHandler) error {
+	res, _, err := i.vtbl.ClearBrowsingData.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(dataKinds),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+// ClearBrowsingDataInTimeRange clears the data which has been stored between startTime and endTime, both are
+// seconds since the UNIX epoch.
+func (i *ICoreWebView2Profile2) ClearBrowsingDataInTimeRange(dataKinds COREWEBVIEW2_BROWSING_DATA_KINDS, startTime float64, endTime float64, handler *ICoreWebView2ClearBrowsingDataCompletedHandler) error {
+	res, _, err := i.vtbl.ClearBrowsingDataInTimeRange.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(dataKinds),
+		uintptr(math.Float64bits(startTime)),
+		uintptr(math.Float64bits(endTime)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Profile2) ClearBrowsingDataAll(handler *ICoreWebView2ClearBrowsingDataCompletedHandler) error {
+	res, _, err := i.vtbl.ClearBrowsingDataAll.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ServerCertificateErrorDetectedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetErrorStatus       ComProc
+	GetRequestUri        ComProc
+	GetServerCertificate ComProc
+	GetAction            ComProc
+	PutAction            ComProc
+	GetDeferral          ComProc
+}
+
+type ICoreWebView2ServerCertificateErrorDetectedEventArgs struct {
+	vtbl *_ICoreWebView2ServerCertificateErrorDetectedEventArgsVtbl
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) GetErrorStatus() (COREWEBVIEW2_WEB_ERROR_STATUS, error) {
+	var errorStatus COREWEBVIEW2_WEB_ERROR_STATUS
+	res, _, err := i.vtbl.GetErrorStatus.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&errorStatus)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return errorStatus, nil
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) GetRequestUri() (string, error) {
+	// Create *uint16 to hold result
+	var _requestUri *uint16
+	res, _, err := i.vtbl.GetRequestUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_requestUri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	requestUri := windows.UTF16PtrToString(_requestUri)
+	windows.CoTaskMemFree(unsafe.Pointer(_requestUri))
+	return requestUri, nil
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) GetServerCertificate() (*ICoreWebView2Certificate, error) {
+	var serverCertificate *ICoreWebView2Certificate
+	res, _, err := i.vtbl.GetServerCertificate.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&serverCertificate)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return serverCertificate, nil
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) GetAction() (COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION, error) {
+	var action COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION
+	res, _, err := i.vtbl.GetAction.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&action)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return action, nil
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) PutAction(action COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION) error {
+	res, _, err := i.vtbl.PutAction.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(action),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+	res, _, err := i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return deferral, nil
+}
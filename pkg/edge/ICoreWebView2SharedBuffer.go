@@ -0,0 +1,72 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2SharedBufferVtbl struct {
+	_IUnknownVtbl
+	GetSize              ComProc
+	GetBuffer            ComProc
+	OpenStream           ComProc
+	GetFileMappingHandle ComProc
+	Close                ComProc
+}
+
+// ICoreWebView2SharedBuffer is a block of memory which is shared between the host and scripts.
+type ICoreWebView2SharedBuffer struct {
+	vtbl *_ICoreWebView2SharedBufferVtbl
+}
+
+// GetBuffer returns the address of the shared memory, it is valid until the buffer is closed.
+func (i *ICoreWebView2SharedBuffer) GetBuffer() (unsafe.Pointer, error) {
+	var buffer unsafe.Pointer
+	res, _, err := i.vtbl.GetBuffer.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&buffer)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return nil, syscall.Errno(res)
+	}
+	return buffer, nil
+}
+
+func (i *ICoreWebView2SharedBuffer) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2SharedBuffer) GetSize() (uint64, error) {
+	var size uint64
+	res, _, err := i.vtbl.GetSize.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&size)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return size, nil
+}
+
+func (i *ICoreWebView2SharedBuffer) Close() error {
+	res, _, err := i.vtbl.Close.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2StringCollectionVtbl struct {
+	_IUnknownVtbl
+	GetCount        ComProc
+	GetValueAtIndex ComProc
+}
+
+type ICoreWebView2StringCollection struct {
+	vtbl *_ICoreWebView2StringCollectionVtbl
+}
+
+func (i *ICoreWebView2StringCollection) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2StringCollection) GetCount() (uint32, error) {
+	var count uint32
+	res, _, err := i.vtbl.GetCount.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&count)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return count, nil
+}
+
+func (i *ICoreWebView2StringCollection) GetValueAtIndex(index uint32) (string, error) {
+	var _value *uint16
+	res, _, err := i.vtbl.GetValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
+
+// Strings returns all values of the collection.
+func (i *ICoreWebView2StringCollection) Strings() ([]string, error) {
+	count, err := i.GetCount()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, count)
+	for index := uint32(0); index < count; index++ {
+		value, err := i.GetValueAtIndex(index)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
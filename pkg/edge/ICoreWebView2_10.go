@@ -0,0 +1,52 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_10Vtbl struct {
+	iCoreWebView2_9Vtbl
+	AddBasicAuthenticationRequested    ComProc
+	RemoveBasicAuthenticationRequested ComProc
+}
+
+type ICoreWebView2_10 struct {
+	vtbl *iCoreWebView2_10Vtbl
+}
+
+func (i *ICoreWebView2_10) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_10) AddBasicAuthenticationRequested(eventHandler *ICoreWebView2BasicAuthenticationRequestedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddBasicAuthenticationRequested.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GetICoreWebView2_10() *ICoreWebView2_10 {
+	var result *ICoreWebView2_10
+
+	iidICoreWebView2_10 := NewGUID("{b1690564-6f5a-4983-8e48-31d1143fecdb}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2_10)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_10() *ICoreWebView2_10 {
+	return e.webview.GetICoreWebView2_10()
+}
@@ -0,0 +1,53 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_11Vtbl struct {
+	iCoreWebView2_10Vtbl
+	CallDevToolsProtocolMethodForSession ComProc
+	AddContextMenuRequested              ComProc
+	RemoveContextMenuRequested           ComProc
+}
+
+type ICoreWebView2_11 struct {
+	vtbl *iCoreWebView2_11Vtbl
+}
+
+func (i *ICoreWebView2_11) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_11) AddContextMenuRequested(eventHandler *ICoreWebView2ContextMenuRequestedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddContextMenuRequested.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GetICoreWebView2_11() *ICoreWebView2_11 {
+	var result *ICoreWebView2_11
+
+	iidICoreWebView2_11 := NewGUID("{0be78e56-c193-4051-b943-23b460c08bdb}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2_11)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_11() *ICoreWebView2_11 {
+	return e.webview.GetICoreWebView2_11()
+}
@@ -0,0 +1,35 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+)
+
+type iCoreWebView2_15Vtbl struct {
+	iCoreWebView2_14Vtbl
+	AddFaviconChanged    ComProc
+	RemoveFaviconChanged ComProc
+	GetFaviconUri        ComProc
+	GetFavicon           ComProc
+}
+
+type ICoreWebView2_15 struct {
+	vtbl *iCoreWebView2_15Vtbl
+}
+
+func (i *ICoreWebView2) GetICoreWebView2_15() *ICoreWebView2_15 {
+	var result *ICoreWebView2_15
+
+	iidICoreWebView2_15 := NewGUID("{517B2D1D-7DAE-4A66-A4F4-10352FFB9518}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2_15)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_15() *ICoreWebView2_15 {
+	return e.webview.GetICoreWebView2_15()
+}
@@ -0,0 +1,85 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_16Vtbl struct {
+	iCoreWebView2_15Vtbl
+	Print            ComProc
+	ShowPrintUI      ComProc
+	PrintToPdfStream ComProc
+}
+
+type ICoreWebView2_16 struct {
+	vtbl *iCoreWebView2_16Vtbl
+}
+
+func (i *ICoreWebView2_16) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2) GetICoreWebView2_16() *ICoreWebView2_16 {
+	var result *ICoreWebView2_16
+
+	iidICoreWebView2_16 := NewGUID("{0EB34DC9-9F91-41E1-8639-95CD5943906B}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2_16)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_16() *ICoreWebView2_16 {
+	return e.webview.GetICoreWebView2_16()
+}
+
+func (i *ICoreWebView2_16) Print(printSettings *ICoreWebView2PrintSettings, handler *ICoreWebView2PrintCompletedHandler) error {
+	res, _, err := i.vtbl.Print.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(printSettings)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_16) ShowPrintUI(printDialogKind COREWEBVIEW2_PRINT_DIALOG_KIND) error {
+	res, _, err := i.vtbl.ShowPrintUI.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(printDialogKind),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_16) PrintToPdfStream(printSettings *ICoreWebView2PrintSettings, handler *ICoreWebView2PrintToPdfStreamCompletedHandler) error {
+	res, _, err := i.vtbl.PrintToPdfStream.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(printSettings)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
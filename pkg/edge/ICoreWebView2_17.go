@@ -0,0 +1,64 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_17Vtbl struct {
+	iCoreWebView2_16Vtbl
+	PostSharedBufferToScript ComProc
+}
+
+type ICoreWebView2_17 struct {
+	vtbl *iCoreWebView2_17Vtbl
+}
+
+func (i *ICoreWebView2_17) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_17) PostSharedBufferToScript(sharedBuffer *ICoreWebView2SharedBuffer, access COREWEBVIEW2_SHARED_BUFFER_ACCESS, additionalDataAsJSON string) error {
+	var _additionalDataAsJSON *uint16
+	if additionalDataAsJSON != "" {
+		var err error
+		_additionalDataAsJSON, err = windows.UTF16PtrFromString(additionalDataAsJSON)
+		if err != nil {
+			return err
+		}
+	}
+
+	res, _, err := i.vtbl.PostSharedBufferToScript.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(sharedBuffer)),
+		uintptr(access),
+		uintptr(unsafe.Pointer(_additionalDataAsJSON)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GetICoreWebView2_17() *ICoreWebView2_17 {
+	var result *ICoreWebView2_17
+
+	iidICoreWebView2_17 := NewGUID("{702E75D4-FD44-434D-9D70-1A68A6B1192A}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2_17)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_17() *ICoreWebView2_17 {
+	return e.webview.GetICoreWebView2_17()
+}
@@ -0,0 +1,52 @@
+//go:build windows
+
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_18Vtbl struct {
+	iCoreWebView2_17Vtbl
+	AddLaunchingExternalUriScheme    ComProc
+	RemoveLaunchingExternalUriScheme ComProc
+}
+
+type ICoreWebView2_18 struct {
+	vtbl *iCoreWebView2_18Vtbl
+}
+
+func (i *ICoreWebView2_18) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_18) AddLaunchingExternalUriScheme(eventHandler *ICoreWebView2LaunchingExternalUriSchemeEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddLaunchingExternalUriScheme.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GetICoreWebView2_18() *ICoreWebView2_18 {
+	var result *ICoreWebView2_18
+
+	iidICoreWebView2_18 := NewGUID("{7A626017-28BE-49B2-B865-3BA2B3522D90}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2_18)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_18() *ICoreWebView2_18 {
+	return e.webview.GetICoreWebView2_18()
+}
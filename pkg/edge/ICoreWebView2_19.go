@@ -0,0 +1,69 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_19Vtbl struct {
+	iCoreWebView2_18Vtbl
+	GetMemoryUsageTargetLevel ComProc
+	PutMemoryUsageTargetLevel ComProc
+}
+
+type ICoreWebView2_19 struct {
+	vtbl *iCoreWebView2_19Vtbl
+}
+
+func (i *ICoreWebView2_19) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_19) GetMemoryUsageTargetLevel() (COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL, error) {
+	var memoryUsageTargetLevel COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL
+	res, _, err := i.vtbl.GetMemoryUsageTargetLevel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&memoryUsageTargetLevel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return memoryUsageTargetLevel, nil
+}
+
+func (i *ICoreWebView2_19) PutMemoryUsageTargetLevel(memoryUsageTargetLevel COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL) error {
+	res, _, err := i.vtbl.PutMemoryUsageTargetLevel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(memoryUsageTargetLevel),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GetICoreWebView2_19() *ICoreWebView2_19 {
+	var result *ICoreWebView2_19
+
+	iidICoreWebView2_19 := NewGUID("{6921F954-79B0-437F-A997-C85811897C68}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2_19)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_19() *ICoreWebView2_19 {
+	return e.webview.GetICoreWebView2_19()
+}
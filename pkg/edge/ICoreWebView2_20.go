@@ -0,0 +1,55 @@
+//go:build windows
+
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_20Vtbl struct {
+	iCoreWebView2_19Vtbl
+	GetFrameId ComProc
+}
+
+type ICoreWebView2_20 struct {
+	vtbl *iCoreWebView2_20Vtbl
+}
+
+func (i *ICoreWebView2_20) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
+// GetFrameId returns the ID of the main frame, it matches the frame IDs reported by GetProcessExtendedInfos.
+func (i *ICoreWebView2_20) GetFrameId() (uint32, error) {
+	var frameId uint32
+	res, _, err := i.vtbl.GetFrameId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&frameId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return frameId, nil
+}
+
+func (i *ICoreWebView2) GetICoreWebView2_20() *ICoreWebView2_20 {
+	var result *ICoreWebView2_20
+
+	iidICoreWebView2_20 := NewGUID("{B4BC1926-7305-11EE-B962-0242AC120002}")
+	_, _, _ = i.vtbl.QueryInterface.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(iidICoreWebView2_20)),
+		uintptr(unsafe.Pointer(&result)))
+
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_20() *ICoreWebView2_20 {
+	return e.webview.GetICoreWebView2_20()
+}
@@ -3,6 +3,7 @@
 package edge
 
 import (
+	"syscall"
 	"unsafe"
 
 	"golang.org/x/sys/windows"
@@ -21,6 +22,10 @@ type ICoreWebView2_3 struct {
 	vtbl *iCoreWebView2_3Vtbl
 }
 
+func (i *ICoreWebView2_3) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
+}
+
 func (i *ICoreWebView2_3) SetVirtualHostNameToFolderMapping(hostName, folderPath string, accessKind COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND) error {
 	_hostName, err := windows.UTF16PtrFromString(hostName)
 	if err != nil {
@@ -45,6 +50,48 @@ func (i *ICoreWebView2_3) SetVirtualHostNameToFolderMapping(hostName, folderPath
 	return nil
 }
 
+func (i *ICoreWebView2_3) TrySuspend(handler *ICoreWebView2TrySuspendCompletedHandler) error {
+	res, _, err := i.vtbl.TrySuspend.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_3) Resume() error {
+	res, _, err := i.vtbl.Resume.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_3) GetIsSuspended() (bool, error) {
+	var isSuspended int32
+	res, _, err := i.vtbl.GetIsSuspended.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isSuspended)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isSuspended != 0, nil
+}
+
 func (i *ICoreWebView2) GetICoreWebView2_3() *ICoreWebView2_3 {
 	var result *ICoreWebView2_3
 
@@ -3,6 +3,7 @@
 package edge
 
 import (
+	"syscall"
 	"unsafe"
 
 	"golang.org/x/sys/windows"
@@ -42,6 +43,12 @@ type _ICoreWebViewSettingsVtbl struct {
 	PutIsPinchZoomEnabled               ComProc
 	GetIsSwipeNavigationEnabled         ComProc // ICoreWebView2Settings6: SDK 1.0.992.28
 	PutIsSwipeNavigationEnabled         ComProc
+	GetHiddenPdfToolbarItems            ComProc // ICoreWebView2Settings7: SDK 1.0.1185.39
+	PutHiddenPdfToolbarItems            ComProc
+	GetIsReputationCheckingRequired     ComProc // ICoreWebView2Settings8: SDK 1.0.1722.45
+	PutIsReputationCheckingRequired     ComProc
+	GetIsNonClientRegionSupportEnabled  ComProc // ICoreWebView2Settings9: SDK 1.0.2420.47
+	PutIsNonClientRegionSupportEnabled  ComProc
 }
 
 type ICoreWebViewSettings struct {
@@ -49,12 +56,17 @@ type ICoreWebViewSettings struct {
 }
 
 func (i *ICoreWebViewSettings) AddRef() uintptr {
-	return i.AddRef()
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebViewSettings) Release() error {
+	return i.vtbl.CallRelease(unsafe.Pointer(i))
 }
 
 func (i *ICoreWebViewSettings) GetIsScriptEnabled() (bool, error) {
 	var err error
-	var isScriptEnabled bool
+	var isScriptEnabled int32
 	_, _, err = i.vtbl.GetIsScriptEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&isScriptEnabled)),
@@ -62,7 +74,7 @@ func (i *ICoreWebViewSettings) GetIsScriptEnabled() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return isScriptEnabled, nil
+	return isScriptEnabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutIsScriptEnabled(isScriptEnabled bool) error {
@@ -80,7 +92,7 @@ func (i *ICoreWebViewSettings) PutIsScriptEnabled(isScriptEnabled bool) error {
 
 func (i *ICoreWebViewSettings) GetIsWebMessageEnabled() (bool, error) {
 	var err error
-	var isWebMessageEnabled bool
+	var isWebMessageEnabled int32
 	_, _, err = i.vtbl.GetIsWebMessageEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&isWebMessageEnabled)),
@@ -88,7 +100,7 @@ func (i *ICoreWebViewSettings) GetIsWebMessageEnabled() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return isWebMessageEnabled, nil
+	return isWebMessageEnabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutIsWebMessageEnabled(isWebMessageEnabled bool) error {
@@ -106,7 +118,7 @@ func (i *ICoreWebViewSettings) PutIsWebMessageEnabled(isWebMessageEnabled bool)
 
 func (i *ICoreWebViewSettings) GetAreDefaultScriptDialogsEnabled() (bool, error) {
 	var err error
-	var areDefaultScriptDialogsEnabled bool
+	var areDefaultScriptDialogsEnabled int32
 	_, _, err = i.vtbl.GetAreDefaultScriptDialogsEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&areDefaultScriptDialogsEnabled)),
@@ -114,7 +126,7 @@ func (i *ICoreWebViewSettings) GetAreDefaultScriptDialogsEnabled() (bool, error)
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return areDefaultScriptDialogsEnabled, nil
+	return areDefaultScriptDialogsEnabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutAreDefaultScriptDialogsEnabled(areDefaultScriptDialogsEnabled bool) error {
@@ -132,7 +144,7 @@ func (i *ICoreWebViewSettings) PutAreDefaultScriptDialogsEnabled(areDefaultScrip
 
 func (i *ICoreWebViewSettings) GetIsStatusBarEnabled() (bool, error) {
 	var err error
-	var isStatusBarEnabled bool
+	var isStatusBarEnabled int32
 	_, _, err = i.vtbl.GetIsStatusBarEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&isStatusBarEnabled)),
@@ -140,7 +152,7 @@ func (i *ICoreWebViewSettings) GetIsStatusBarEnabled() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return isStatusBarEnabled, nil
+	return isStatusBarEnabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutIsStatusBarEnabled(isStatusBarEnabled bool) error {
@@ -158,7 +170,7 @@ func (i *ICoreWebViewSettings) PutIsStatusBarEnabled(isStatusBarEnabled bool) er
 
 func (i *ICoreWebViewSettings) GetAreDevToolsEnabled() (bool, error) {
 	var err error
-	var areDevToolsEnabled bool
+	var areDevToolsEnabled int32
 	_, _, err = i.vtbl.GetAreDevToolsEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&areDevToolsEnabled)),
@@ -166,7 +178,7 @@ func (i *ICoreWebViewSettings) GetAreDevToolsEnabled() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return areDevToolsEnabled, nil
+	return areDevToolsEnabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutAreDevToolsEnabled(areDevToolsEnabled bool) error {
@@ -183,7 +195,7 @@ func (i *ICoreWebViewSettings) PutAreDevToolsEnabled(areDevToolsEnabled bool) er
 
 func (i *ICoreWebViewSettings) GetAreDefaultContextMenusEnabled() (bool, error) {
 	var err error
-	var enabled bool
+	var enabled int32
 	_, _, err = i.vtbl.GetAreDefaultContextMenusEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&enabled)),
@@ -191,7 +203,7 @@ func (i *ICoreWebViewSettings) GetAreDefaultContextMenusEnabled() (bool, error)
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return enabled, nil
+	return enabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutAreDefaultContextMenusEnabled(enabled bool) error {
@@ -208,7 +220,7 @@ func (i *ICoreWebViewSettings) PutAreDefaultContextMenusEnabled(enabled bool) er
 
 func (i *ICoreWebViewSettings) GetAreHostObjectsAllowed() (bool, error) {
 	var err error
-	var allowed bool
+	var allowed int32
 	_, _, err = i.vtbl.GetAreHostObjectsAllowed.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&allowed)),
@@ -216,7 +228,7 @@ func (i *ICoreWebViewSettings) GetAreHostObjectsAllowed() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return allowed, nil
+	return allowed != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutAreHostObjectsAllowed(allowed bool) error {
@@ -234,7 +246,7 @@ func (i *ICoreWebViewSettings) PutAreHostObjectsAllowed(allowed bool) error {
 
 func (i *ICoreWebViewSettings) GetIsZoomControlEnabled() (bool, error) {
 	var err error
-	var enabled bool
+	var enabled int32
 	_, _, err = i.vtbl.GetIsZoomControlEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&enabled)),
@@ -242,7 +254,7 @@ func (i *ICoreWebViewSettings) GetIsZoomControlEnabled() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return enabled, nil
+	return enabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutIsZoomControlEnabled(enabled bool) error {
@@ -260,7 +272,7 @@ func (i *ICoreWebViewSettings) PutIsZoomControlEnabled(enabled bool) error {
 
 func (i *ICoreWebViewSettings) GetIsBuiltInErrorPageEnabled() (bool, error) {
 	var err error
-	var enabled bool
+	var enabled int32
 	_, _, err = i.vtbl.GetIsBuiltInErrorPageEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&enabled)),
@@ -268,7 +280,7 @@ func (i *ICoreWebViewSettings) GetIsBuiltInErrorPageEnabled() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return enabled, nil
+	return enabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutIsBuiltInErrorPageEnabled(enabled bool) error {
@@ -290,7 +302,7 @@ func (i *ICoreWebViewSettings) GetUserAgent() (string, error) {
 	var _userAgent *uint16
 	_, _, err = i.vtbl.GetUserAgent.Call(
 		uintptr(unsafe.Pointer(i)),
-		uintptr(unsafe.Pointer(_userAgent)),
+		uintptr(unsafe.Pointer(&_userAgent)),
 	)
 	if err != windows.ERROR_SUCCESS {
 		return "", err
@@ -320,7 +332,7 @@ func (i *ICoreWebViewSettings) PutUserAgent(userAgent string) error {
 
 func (i *ICoreWebViewSettings) GetAreBrowserAcceleratorKeysEnabled() (bool, error) {
 	var err error
-	var enabled bool
+	var enabled int32
 	_, _, err = i.vtbl.GetAreBrowserAcceleratorKeysEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&enabled)),
@@ -328,7 +340,7 @@ func (i *ICoreWebViewSettings) GetAreBrowserAcceleratorKeysEnabled() (bool, erro
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return enabled, nil
+	return enabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutAreBrowserAcceleratorKeysEnabled(enabled bool) error {
@@ -346,7 +358,7 @@ func (i *ICoreWebViewSettings) PutAreBrowserAcceleratorKeysEnabled(enabled bool)
 
 func (i *ICoreWebViewSettings) GetIsPinchZoomEnabled() (bool, error) {
 	var err error
-	var enabled bool
+	var enabled int32
 	_, _, err = i.vtbl.GetIsPinchZoomEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&enabled)),
@@ -354,7 +366,7 @@ func (i *ICoreWebViewSettings) GetIsPinchZoomEnabled() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return enabled, nil
+	return enabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutIsPinchZoomEnabled(enabled bool) error {
@@ -372,7 +384,7 @@ func (i *ICoreWebViewSettings) PutIsPinchZoomEnabled(enabled bool) error {
 
 func (i *ICoreWebViewSettings) GetIsSwipeNavigationEnabled() (bool, error) {
 	var err error
-	var enabled bool
+	var enabled int32
 	_, _, err = i.vtbl.GetIsSwipeNavigationEnabled.Call(
 		uintptr(unsafe.Pointer(i)),
 		uintptr(unsafe.Pointer(&enabled)),
@@ -380,7 +392,7 @@ func (i *ICoreWebViewSettings) GetIsSwipeNavigationEnabled() (bool, error) {
 	if err != windows.ERROR_SUCCESS {
 		return false, err
 	}
-	return enabled, nil
+	return enabled != 0, nil
 }
 
 func (i *ICoreWebViewSettings) PutIsSwipeNavigationEnabled(enabled bool) error {
@@ -395,3 +407,181 @@ func (i *ICoreWebViewSettings) PutIsSwipeNavigationEnabled(enabled bool) error {
 	}
 	return nil
 }
+
+func (i *ICoreWebViewSettings) GetIsPasswordAutosaveEnabled() (bool, error) {
+	var isPasswordAutosaveEnabled int32
+	res, _, err := i.vtbl.GetIsPasswordAutosaveEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isPasswordAutosaveEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isPasswordAutosaveEnabled != 0, nil
+}
+
+func (i *ICoreWebViewSettings) PutIsPasswordAutosaveEnabled(isPasswordAutosaveEnabled bool) error {
+	res, _, err := i.vtbl.PutIsPasswordAutosaveEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isPasswordAutosaveEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebViewSettings) GetIsGeneralAutofillEnabled() (bool, error) {
+	var isGeneralAutofillEnabled int32
+	res, _, err := i.vtbl.GetIsGeneralAutofillEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isGeneralAutofillEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isGeneralAutofillEnabled != 0, nil
+}
+
+func (i *ICoreWebViewSettings) PutIsGeneralAutofillEnabled(isGeneralAutofillEnabled bool) error {
+	res, _, err := i.vtbl.PutIsGeneralAutofillEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isGeneralAutofillEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebViewSettings) GetHiddenPdfToolbarItems() (COREWEBVIEW2_PDF_TOOLBAR_ITEMS, error) {
+	var hiddenPdfToolbarItems COREWEBVIEW2_PDF_TOOLBAR_ITEMS
+	res, _, err := i.vtbl.GetHiddenPdfToolbarItems.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hiddenPdfToolbarItems)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return hiddenPdfToolbarItems, nil
+}
+
+func (i *ICoreWebViewSettings) PutHiddenPdfToolbarItems(hiddenPdfToolbarItems COREWEBVIEW2_PDF_TOOLBAR_ITEMS) error {
+	res, _, err := i.vtbl.PutHiddenPdfToolbarItems.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(hiddenPdfToolbarItems),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebViewSettings) GetIsReputationCheckingRequired() (bool, error) {
+	var isReputationCheckingRequired int32
+	res, _, err := i.vtbl.GetIsReputationCheckingRequired.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isReputationCheckingRequired)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isReputationCheckingRequired != 0, nil
+}
+
+func (i *ICoreWebViewSettings) PutIsReputationCheckingRequired(isReputationCheckingRequired bool) error {
+	res, _, err := i.vtbl.PutIsReputationCheckingRequired.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isReputationCheckingRequired)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+func (i *ICoreWebViewSettings) GetIsNonClientRegionSupportEnabled() (bool, error) {
+	var isNonClientRegionSupportEnabled int32
+	res, _, err := i.vtbl.GetIsNonClientRegionSupportEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isNonClientRegionSupportEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return false, syscall.Errno(res)
+	}
+	return isNonClientRegionSupportEnabled != 0, nil
+}
+
+func (i *ICoreWebViewSettings) PutIsNonClientRegionSupportEnabled(isNonClientRegionSupportEnabled bool) error {
+	res, _, err := i.vtbl.PutIsNonClientRegionSupportEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isNonClientRegionSupportEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
+// iidICoreWebViewSettings holds the IIDs of ICoreWebView2Settings2 to ICoreWebView2Settings9, the settings object
+// only implements the interfaces of the runtime it was created by.
+var iidICoreWebViewSettings = []string{
+	"{EE9A0F68-F46C-4E32-AC23-EF8CAC224D2A}",
+	"{FDB5AB74-AF33-4854-84F0-0A631DEB5EBA}",
+	"{CB56846C-4168-4D53-B04F-03B6D6796FF2}",
+	"{183E7052-1D03-43A0-AB99-98E043B66B39}",
+	"{11CB3ACD-9BC8-43B8-83BF-F40753714F87}",
+	"{488DC902-35EF-42D2-BC7D-94B65C4BC49C}",
+	"{9E6B0E8F-86AD-4E81-8147-A9B5EDB68650}",
+	"{0528A73B-E92D-49F4-927A-E547DDDAA37D}",
+}
+
+// Version returns the newest ICoreWebView2SettingsN interface implemented by the settings, 1 means only
+// ICoreWebView2Settings is available. Calling methods of a newer interface than the returned version is not allowed.
+func (i *ICoreWebViewSettings) Version() int {
+	version := 1
+	for n, iid := range iidICoreWebViewSettings {
+		// All settings interfaces extend ICoreWebView2Settings
+		var result *ICoreWebViewSettings
+		_, _, _ = i.vtbl.QueryInterface.Call(
+			uintptr(unsafe.Pointer(i)),
+			uintptr(unsafe.Pointer(NewGUID(iid))),
+			uintptr(unsafe.Pointer(&result)))
+		if result == nil {
+			break
+		}
+		result.vtbl.CallRelease(unsafe.Pointer(result))
+		version = n + 2
+	}
+	return version
+}
@@ -0,0 +1,126 @@
+//go:build windows
+
+package edge
+
+import (
+	"errors"
+	"log"
+)
+
+var ErrAlreadyResponded = errors.New("the request has already been responded to")
+
+// CredentialsProvider is asked for credentials when a page requests HTTP basic or NTLM authentication.
+type CredentialsProvider interface {
+	// ProvideCredentials is called on the UI thread. It answers the request with Provide, Cancel or Default, if it
+	// doesn't respond at all the default login dialog is shown. For asynchronous lookups Defer must be called
+	// before returning, the request can then be answered later on the UI thread.
+	ProvideCredentials(request *CredentialsRequest)
+}
+
+// CredentialsProviderFunc allows to use an ordinary function as CredentialsProvider.
+type CredentialsProviderFunc func(request *CredentialsRequest)
+
+func (f CredentialsProviderFunc) ProvideCredentials(request *CredentialsRequest) {
+	f(request)
+}
+
+// CredentialsRequest is a HTTP authentication challenge of a page.
+type CredentialsRequest struct {
+	// URI of the request which was answered with the challenge.
+	URI string
+	// Challenge is the value of the WWW-Authenticate header of the response.
+	Challenge string
+
+	args      *ICoreWebView2BasicAuthenticationRequestedEventArgs
+	deferral  *ICoreWebView2Deferral
+	responded bool
+}
+
+// Defer postpones the response until Provide, Cancel or Default is called, which must happen on the UI thread.
+func (r *CredentialsRequest) Defer() error {
+	if r.responded {
+		return ErrAlreadyResponded
+	}
+	if r.deferral != nil {
+		return nil
+	}
+
+	deferral, err := r.args.GetDeferral()
+	if err != nil {
+		return err
+	}
+	r.args.AddRef()
+	r.deferral = deferral
+	return nil
+}
+
+// Provide answers the challenge with the given username and password.
+func (r *CredentialsRequest) Provide(username, password string) error {
+	if r.responded {
+		return ErrAlreadyResponded
+	}
+
+	response, err := r.args.GetResponse()
+	if err != nil {
+		return err
+	}
+	defer response.Release()
+
+	if err := response.PutUserName(username); err != nil {
+		return err
+	}
+	if err := response.PutPassword(password); err != nil {
+		return err
+	}
+	return r.respond()
+}
+
+// Cancel aborts the authentication, the page receives the response of the challenge.
+func (r *CredentialsRequest) Cancel() error {
+	if r.responded {
+		return ErrAlreadyResponded
+	}
+	if err := r.args.PutCancel(true); err != nil {
+		return err
+	}
+	return r.respond()
+}
+
+// Default lets the webview show its default login dialog.
+func (r *CredentialsRequest) Default() error {
+	if r.responded {
+		return ErrAlreadyResponded
+	}
+	return r.respond()
+}
+
+func (r *CredentialsRequest) respond() error {
+	r.responded = true
+	if r.deferral == nil {
+		return nil
+	}
+
+	defer r.args.Release()
+	defer r.deferral.Release()
+	return r.deferral.Complete()
+}
+
+func (e *Chromium) BasicAuthenticationRequested(sender *ICoreWebView2, args *ICoreWebView2BasicAuthenticationRequestedEventArgs) uintptr {
+	if e.CredentialsProvider == nil {
+		return 0
+	}
+
+	var err error
+	request := &CredentialsRequest{args: args}
+	if request.URI, err = args.GetUri(); err != nil {
+		log.Printf("GetUri failed: %v", err)
+		return 0
+	}
+	if request.Challenge, err = args.GetChallenge(); err != nil {
+		log.Printf("GetChallenge failed: %v", err)
+		return 0
+	}
+
+	e.CredentialsProvider.ProvideCredentials(request)
+	return 0
+}
@@ -0,0 +1,104 @@
+//go:build windows
+
+package edge
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+)
+
+// X509Chain returns the certificate followed by its issuer chain up to the root certificate.
+func (i *ICoreWebView2Certificate) X509Chain() ([]*x509.Certificate, error) {
+	var encoded []string
+	if collection, err := i.GetPemEncodedIssuerCertificateChain(); err == nil {
+		encoded, err = collection.Strings()
+		collection.Release()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(encoded) == 0 {
+		// The chain should start with the certificate itself, but don't rely on it.
+		certificate, err := i.ToPemEncoding()
+		if err != nil {
+			return nil, err
+		}
+		encoded = []string{certificate}
+	}
+
+	chain := make([]*x509.Certificate, 0, len(encoded))
+	for _, e := range encoded {
+		certificate, err := parsePEMCertificate(e)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, certificate)
+	}
+	return chain, nil
+}
+
+// X509 returns the parsed client certificate.
+func (i *ICoreWebView2ClientCertificate) X509() (*x509.Certificate, error) {
+	encoded, err := i.ToPemEncoding()
+	if err != nil {
+		return nil, err
+	}
+	return parsePEMCertificate(encoded)
+}
+
+func parsePEMCertificate(encoded string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, errors.New("invalid PEM encoded certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func (e *Chromium) ServerCertificateErrorDetected(sender *ICoreWebView2, args *ICoreWebView2ServerCertificateErrorDetectedEventArgs) uintptr {
+	if e.ServerCertificateErrorDetectedCallback != nil {
+		e.ServerCertificateErrorDetectedCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) ClientCertificateRequested(sender *ICoreWebView2, args *ICoreWebView2ClientCertificateRequestedEventArgs) uintptr {
+	if e.ClientCertificateRequestedCallback != nil {
+		e.ClientCertificateRequestedCallback(sender, args)
+	}
+	return 0
+}
+
+// ClearServerCertificateErrorActions forgets all certificates which have been allowed with
+// COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION_ALWAYS_ALLOW. completed is called on the UI thread.
+func (e *Chromium) ClearServerCertificateErrorActions(completed func(err error)) error {
+	webview14 := e.webview.GetICoreWebView2_14()
+	if webview14 == nil {
+		return ErrNotSupported
+	}
+	defer webview14.Release()
+
+	c := &clearServerCertificateErrorActionsCompleted{fn: completed}
+	c.handler = newICoreWebView2ClearServerCertificateErrorActionsCompletedHandler(c)
+	keepAlive(c)
+	if err := webview14.ClearServerCertificateErrorActions(c.handler); err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+type clearServerCertificateErrorActionsCompleted struct {
+	completionImpl
+	handler *ICoreWebView2ClearServerCertificateErrorActionsCompletedHandler
+	fn      func(err error)
+}
+
+func (c *clearServerCertificateErrorActionsCompleted) ClearServerCertificateErrorActionsCompleted(errorCode uintptr) uintptr {
+	releaseKeepAlive(c)
+
+	if c.fn != nil {
+		c.fn(errorFromHRESULT(errorCode))
+	}
+	return 0
+}
@@ -20,37 +20,92 @@ import (
 type Rect = w32.Rect
 
 type Chromium struct {
-	hwnd                  uintptr
-	controller            *ICoreWebView2Controller
-	webview               *ICoreWebView2
-	inited                uintptr
-	envCompleted          *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler
-	controllerCompleted   *iCoreWebView2CreateCoreWebView2ControllerCompletedHandler
-	webMessageReceived    *iCoreWebView2WebMessageReceivedEventHandler
-	permissionRequested   *iCoreWebView2PermissionRequestedEventHandler
-	webResourceRequested  *iCoreWebView2WebResourceRequestedEventHandler
-	acceleratorKeyPressed *ICoreWebView2AcceleratorKeyPressedEventHandler
-	navigationCompleted   *ICoreWebView2NavigationCompletedEventHandler
+	hwnd                           uintptr
+	controller                     *ICoreWebView2Controller
+	webview                        *ICoreWebView2
+	inited                         uintptr
+	envCompleted                   *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler
+	controllerCompleted            *iCoreWebView2CreateCoreWebView2ControllerCompletedHandler
+	webMessageReceived             *iCoreWebView2WebMessageReceivedEventHandler
+	permissionRequested            *iCoreWebView2PermissionRequestedEventHandler
+	webResourceRequested           *iCoreWebView2WebResourceRequestedEventHandler
+	acceleratorKeyPressed          *ICoreWebView2AcceleratorKeyPressedEventHandler
+	navigationCompleted            *ICoreWebView2NavigationCompletedEventHandler
+	navigationStarting             *ICoreWebView2NavigationStartingEventHandler
+	contentLoading                 *ICoreWebView2ContentLoadingEventHandler
+	domContentLoaded               *ICoreWebView2DOMContentLoadedEventHandler
+	downloadStarting               *ICoreWebView2DownloadStartingEventHandler
+	contextMenuRequested           *ICoreWebView2ContextMenuRequestedEventHandler
+	customItemSelected             *ICoreWebView2CustomItemSelectedEventHandler
+	basicAuthenticationRequested   *ICoreWebView2BasicAuthenticationRequestedEventHandler
+	serverCertificateErrorDetected *ICoreWebView2ServerCertificateErrorDetectedEventHandler
+	clientCertificateRequested     *ICoreWebView2ClientCertificateRequestedEventHandler
+	frameCreated                   *ICoreWebView2FrameCreatedEventHandler
+	launchingExternalUriScheme     *ICoreWebView2LaunchingExternalUriSchemeEventHandler
+	processInfosChanged            *ICoreWebView2ProcessInfosChangedEventHandler
 
 	environment *ICoreWebView2Environment
 
 	padding Rect
 
+	// userDataFolder and remoteDebugging are set before the environment is created and only read afterwards, so
+	// RemoteDebuggingPort can be called from any goroutine.
+	userDataFolder  string
+	remoteDebugging bool
+
 	// Settings
+	// Debug marks a debug build of the application, it allows RemoteDebugging.
 	Debug                 bool
 	DataPath              string
 	BrowserPath           string
 	AdditionalBrowserArgs []string
 
+	// ProfileName selects the profile inside of DataPath, webviews with different profiles don't share cookies,
+	// storage or caches. The default profile is used if it is empty.
+	ProfileName string
+	// InPrivate doesn't persist any browsing data of the profile on disk.
+	InPrivate bool
+	// EnvironmentOptions are validated and applied when the environment is created by Embed.
+	EnvironmentOptions EnvironmentOptions
+	// RemoteDebugging exposes the DevTools protocol to external automation tools if it isn't nil.
+	RemoteDebugging *RemoteDebugging
+	// Settings are applied with PutSettings once the webview has been created, the defaults of the runtime are kept
+	// if it is nil.
+	Settings *Settings
+
+	// CredentialsProvider answers HTTP basic and NTLM authentication challenges, the default login dialog is
+	// shown if it is nil.
+	CredentialsProvider CredentialsProvider
+
 	// permissions
 	permissions      map[CoreWebView2PermissionKind]CoreWebView2PermissionState
 	globalPermission *CoreWebView2PermissionState
 
+	// downloads that are still in progress or might be resumed
+	downloads map[uint64]*Download
+
+	// frames of the top level document which haven't been destroyed yet
+	frames map[uint64]*Frame
+
+	// click callbacks of the custom items of the current context menu by their command id
+	contextMenuCommands map[int32]func()
+
 	// Callbacks
-	MessageCallback              func(string)
-	WebResourceRequestedCallback func(request *ICoreWebView2WebResourceRequest, args *ICoreWebView2WebResourceRequestedEventArgs)
-	NavigationCompletedCallback  func(sender *ICoreWebView2, args *ICoreWebView2NavigationCompletedEventArgs)
-	AcceleratorKeyCallback       func(uint) bool
+	MessageCallback                        func(string)
+	WebResourceRequestedCallback           func(request *ICoreWebView2WebResourceRequest, args *ICoreWebView2WebResourceRequestedEventArgs)
+	NavigationCompletedCallback            func(sender *ICoreWebView2, args *ICoreWebView2NavigationCompletedEventArgs)
+	NavigationStartingCallback             func(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs)
+	ContentLoadingCallback                 func(sender *ICoreWebView2, args *ICoreWebView2ContentLoadingEventArgs)
+	DOMContentLoadedCallback               func(sender *ICoreWebView2, args *ICoreWebView2DOMContentLoadedEventArgs)
+	DownloadStartingCallback               func(download *Download, args *ICoreWebView2DownloadStartingEventArgs)
+	ContextMenuRequestedCallback           func(sender *ICoreWebView2, args *ICoreWebView2ContextMenuRequestedEventArgs)
+	ServerCertificateErrorDetectedCallback func(sender *ICoreWebView2, args *ICoreWebView2ServerCertificateErrorDetectedEventArgs)
+	ClientCertificateRequestedCallback     func(sender *ICoreWebView2, args *ICoreWebView2ClientCertificateRequestedEventArgs)
+	FrameCreatedCallback                   func(frame *Frame)
+	LaunchingExternalUriSchemeCallback     func(sender *ICoreWebView2, args *ICoreWebView2LaunchingExternalUriSchemeEventArgs)
+	PermissionRequestedCallback            func(sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs)
+	ProcessInfosChangedCallback            func()
+	AcceleratorKeyCallback                 func(uint) bool
 }
 
 func NewChromium() *Chromium {
@@ -73,7 +128,22 @@ func NewChromium() *Chromium {
 	e.webResourceRequested = newICoreWebView2WebResourceRequestedEventHandler(e)
 	e.acceleratorKeyPressed = newICoreWebView2AcceleratorKeyPressedEventHandler(e)
 	e.navigationCompleted = newICoreWebView2NavigationCompletedEventHandler(e)
+	e.navigationStarting = newICoreWebView2NavigationStartingEventHandler(e)
+	e.contentLoading = newICoreWebView2ContentLoadingEventHandler(e)
+	e.domContentLoaded = newICoreWebView2DOMContentLoadedEventHandler(e)
+	e.downloadStarting = newICoreWebView2DownloadStartingEventHandler(e)
+	e.contextMenuRequested = newICoreWebView2ContextMenuRequestedEventHandler(e)
+	e.customItemSelected = newICoreWebView2CustomItemSelectedEventHandler(e)
+	e.basicAuthenticationRequested = newICoreWebView2BasicAuthenticationRequestedEventHandler(e)
+	e.serverCertificateErrorDetected = newICoreWebView2ServerCertificateErrorDetectedEventHandler(e)
+	e.clientCertificateRequested = newICoreWebView2ClientCertificateRequestedEventHandler(e)
+	e.frameCreated = newICoreWebView2FrameCreatedEventHandler(e)
+	e.launchingExternalUriScheme = newICoreWebView2LaunchingExternalUriSchemeEventHandler(e)
+	e.processInfosChanged = newICoreWebView2ProcessInfosChangedEventHandler(e)
 	e.permissions = make(map[CoreWebView2PermissionKind]CoreWebView2PermissionState)
+	e.downloads = make(map[uint64]*Download)
+	e.contextMenuCommands = make(map[int32]func())
+	e.frames = make(map[uint64]*Frame)
 
 	return e
 }
@@ -100,8 +170,24 @@ func (e *Chromium) Embed(hwnd uintptr) bool {
 		}
 	}
 
-	browserArgs := strings.Join(e.AdditionalBrowserArgs, " ")
-	if err := createCoreWebView2EnvironmentWithOptions(e.BrowserPath, dataPath, e.envCompleted, browserArgs); err != nil {
+	if err := e.EnvironmentOptions.Validate(e.AdditionalBrowserArgs); err != nil {
+		log.Printf("Invalid environment options: %v", err)
+		return false
+	}
+
+	e.userDataFolder = dataPath
+	browserArgs := e.AdditionalBrowserArgs
+	if e.RemoteDebugging != nil {
+		args, err := e.remoteDebuggingArgs()
+		if err != nil {
+			log.Printf("Not enabling remote debugging: %v", err)
+		} else {
+			browserArgs = append(append([]string(nil), browserArgs...), args...)
+			e.remoteDebugging = true
+		}
+	}
+
+	if err := createCoreWebView2EnvironmentWithOptions(e.BrowserPath, dataPath, e.envCompleted, strings.Join(browserArgs, " "), &e.EnvironmentOptions); err != nil {
 		log.Printf("Error calling Webview2Loader: %v", err)
 		return false
 	}
@@ -209,6 +295,18 @@ func (e *Chromium) EnvironmentCompleted(res uintptr, env *ICoreWebView2Environme
 	}
 	env.vtbl.AddRef.Call(uintptr(unsafe.Pointer(env)))
 	e.environment = env
+	if env8 := env.GetICoreWebView2Environment8(); env8 != nil {
+		var token _EventRegistrationToken
+		env8.AddProcessInfosChanged(e.processInfosChanged, &token)
+		env8.Release()
+	}
+
+	if e.ProfileName != "" || e.InPrivate {
+		if err := e.createControllerWithOptions(); err != nil {
+			log.Fatalf("Creating controller with profile %q failed: %v", e.ProfileName, err)
+		}
+		return 0
+	}
 
 	env.vtbl.CreateCoreWebView2Controller.Call(
 		uintptr(unsafe.Pointer(env)),
@@ -254,8 +352,55 @@ func (e *Chromium) CreateCoreWebView2ControllerCompleted(res uintptr, controller
 		uintptr(unsafe.Pointer(&token)),
 	)
 
+	e.webview.vtbl.AddNavigationStarting.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.navigationStarting)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	e.webview.vtbl.AddContentLoading.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.contentLoading)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	if webview2 := e.webview.GetICoreWebView2_2(); webview2 != nil {
+		// DOMContentLoaded is only available on ICoreWebView2_2, older runtimes won't ever fire it.
+		webview2.AddDOMContentLoaded(e.domContentLoaded, &token)
+		webview2.Release()
+	}
+	if webview4 := e.webview.GetICoreWebView2_4(); webview4 != nil {
+		webview4.AddDownloadStarting(e.downloadStarting, &token)
+		webview4.AddFrameCreated(e.frameCreated, &token)
+		webview4.Release()
+	}
+	if webview11 := e.webview.GetICoreWebView2_11(); webview11 != nil {
+		webview11.AddContextMenuRequested(e.contextMenuRequested, &token)
+		webview11.Release()
+	}
+	if webview10 := e.webview.GetICoreWebView2_10(); webview10 != nil {
+		webview10.AddBasicAuthenticationRequested(e.basicAuthenticationRequested, &token)
+		webview10.Release()
+	}
+	if webview14 := e.webview.GetICoreWebView2_14(); webview14 != nil {
+		webview14.AddServerCertificateErrorDetected(e.serverCertificateErrorDetected, &token)
+		webview14.Release()
+	}
+	if webview5 := e.webview.GetICoreWebView2_5(); webview5 != nil {
+		webview5.AddClientCertificateRequested(e.clientCertificateRequested, &token)
+		webview5.Release()
+	}
+	if webview18 := e.webview.GetICoreWebView2_18(); webview18 != nil {
+		webview18.AddLaunchingExternalUriScheme(e.launchingExternalUriScheme, &token)
+		webview18.Release()
+	}
+
 	e.controller.AddAcceleratorKeyPressed(e.acceleratorKeyPressed, &token)
 
+	if e.Settings != nil {
+		if err := e.PutSettings(*e.Settings); err != nil {
+			log.Printf("Applying the settings failed: %v", err)
+		}
+	}
+
 	atomic.StoreUintptr(&e.inited, 1)
 
 	return 0
@@ -286,12 +431,19 @@ func (e *Chromium) SetGlobalPermission(state CoreWebView2PermissionState) {
 	e.globalPermission = &state
 }
 
-func (e *Chromium) PermissionRequested(_ *ICoreWebView2, args *iCoreWebView2PermissionRequestedEventArgs) uintptr {
-	var kind CoreWebView2PermissionKind
-	args.vtbl.GetPermissionKind.Call(
-		uintptr(unsafe.Pointer(args)),
-		uintptr(kind),
-	)
+// PermissionRequested answers permission requests with the states set by SetPermission and SetGlobalPermission,
+// unless PermissionRequestedCallback is set.
+func (e *Chromium) PermissionRequested(sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs) uintptr {
+	if e.PermissionRequestedCallback != nil {
+		e.PermissionRequestedCallback(sender, args)
+		return 0
+	}
+
+	kind, err := args.GetPermissionKind()
+	if err != nil {
+		log.Printf("GetPermissionKind failed: %v", err)
+		return 0
+	}
 	var result CoreWebView2PermissionState
 	if e.globalPermission != nil {
 		result = *e.globalPermission
@@ -302,10 +454,7 @@ func (e *Chromium) PermissionRequested(_ *ICoreWebView2, args *iCoreWebView2Perm
 			result = CoreWebView2PermissionStateDefault
 		}
 	}
-	args.vtbl.PutState.Call(
-		uintptr(unsafe.Pointer(args)),
-		uintptr(result),
-	)
+	args.PutState(result)
 	return 0
 }
 
@@ -362,6 +511,17 @@ func (e *Chromium) GetController() *ICoreWebView2Controller {
 	return e.controller
 }
 
+// PutDefaultBackgroundColor sets the colour shown behind the page until it paints and behind transparent pages. The
+// runtime only accepts an alpha of 0 or 255, 0 makes the WebView transparent.
+func (e *Chromium) PutDefaultBackgroundColor(color COREWEBVIEW2_COLOR) error {
+	controller2 := e.controller.GetICoreWebView2Controller2()
+	if controller2 == nil {
+		return ErrNotSupported
+	}
+	defer controller2.Release()
+	return controller2.PutDefaultBackgroundColor(color)
+}
+
 func boolToInt(input bool) int {
 	if input {
 		return 1
@@ -376,6 +536,57 @@ func (e *Chromium) NavigationCompleted(sender *ICoreWebView2, args *ICoreWebView
 	return 0
 }
 
+func (e *Chromium) NavigationStarting(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs) uintptr {
+	if e.NavigationStartingCallback != nil {
+		e.NavigationStartingCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) ContentLoading(sender *ICoreWebView2, args *ICoreWebView2ContentLoadingEventArgs) uintptr {
+	if e.ContentLoadingCallback != nil {
+		e.ContentLoadingCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) DOMContentLoaded(sender *ICoreWebView2, args *ICoreWebView2DOMContentLoadedEventArgs) uintptr {
+	if e.DOMContentLoadedCallback != nil {
+		e.DOMContentLoadedCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) DownloadStarting(sender *ICoreWebView2, args *ICoreWebView2DownloadStartingEventArgs) uintptr {
+	operation, err := args.GetDownloadOperation()
+	if err != nil {
+		log.Printf("GetDownloadOperation failed: %v", err)
+		return 0
+	}
+
+	download := newDownload(e, operation)
+	e.downloads[download.ID] = download
+
+	if e.DownloadStartingCallback != nil {
+		e.DownloadStartingCallback(download, args)
+	}
+	return 0
+}
+
+// GetDownload returns the download with the specified id or nil if it is unknown or already finished.
+func (e *Chromium) GetDownload(id uint64) *Download {
+	return e.downloads[id]
+}
+
+func (e *Chromium) finishDownload(download *Download) {
+	if _, found := e.downloads[download.ID]; !found {
+		return
+	}
+	delete(e.downloads, download.ID)
+	download.finished = true
+	download.Operation.Release()
+}
+
 func (e *Chromium) NotifyParentWindowPositionChanged() error {
 	//It looks like the wndproc function is called before the controller initialization is complete.
 	//Because of this the controller is nil
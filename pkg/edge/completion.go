@@ -0,0 +1,57 @@
+//go:build windows
+
+package edge
+
+import (
+	"errors"
+	"sync"
+	"syscall"
+)
+
+// ErrNotSupported is returned if the installed WebView2 runtime doesn't implement the interface needed for a call.
+var ErrNotSupported = errors.New("not supported by the installed WebView2 runtime")
+
+// ErrFrameDestroyed is returned by the methods of a Frame after it has been removed from the document.
+var ErrFrameDestroyed = errors.New("the frame has been destroyed")
+
+// One-shot completion handlers are only referenced by native code until they have been invoked, so we have to keep
+// them reachable from Go until then. See the comment in NewChromium about moving objects.
+var (
+	pendingHandlersL sync.Mutex
+	pendingHandlers  = map[interface{}]struct{}{}
+)
+
+func keepAlive(handler interface{}) {
+	pendingHandlersL.Lock()
+	pendingHandlers[handler] = struct{}{}
+	pendingHandlersL.Unlock()
+}
+
+func releaseKeepAlive(handler interface{}) {
+	pendingHandlersL.Lock()
+	delete(pendingHandlers, handler)
+	pendingHandlersL.Unlock()
+}
+
+// completionImpl implements the IUnknown part of completion handlers that are backed by Go funcs.
+type completionImpl struct{}
+
+func (completionImpl) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (completionImpl) AddRef() uintptr {
+	return 1
+}
+
+func (completionImpl) Release() uintptr {
+	return 1
+}
+
+// errorFromHRESULT returns nil if errorCode is a success code.
+func errorFromHRESULT(errorCode uintptr) error {
+	if int32(errorCode) < 0 {
+		return syscall.Errno(errorCode)
+	}
+	return nil
+}
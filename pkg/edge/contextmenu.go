@@ -0,0 +1,75 @@
+//go:build windows
+
+package edge
+
+import (
+	"log"
+)
+
+func (e *Chromium) ContextMenuRequested(sender *ICoreWebView2, args *ICoreWebView2ContextMenuRequestedEventArgs) uintptr {
+	// Only one context menu can be open at a time, so the items of the previous one can't be selected anymore.
+	e.contextMenuCommands = make(map[int32]func())
+
+	if e.ContextMenuRequestedCallback != nil {
+		e.ContextMenuRequestedCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) CustomItemSelected(sender *ICoreWebView2ContextMenuItem, _ uintptr) uintptr {
+	commandID, err := sender.GetCommandId()
+	if err != nil {
+		log.Printf("GetCommandId failed: %v", err)
+		return 0
+	}
+
+	if onSelected := e.contextMenuCommands[commandID]; onSelected != nil {
+		onSelected()
+	}
+	return 0
+}
+
+// ContextMenuItemsSupported reports if the installed runtime supports custom context menu items.
+func (e *Chromium) ContextMenuItemsSupported() bool {
+	environment9 := e.environment.GetICoreWebView2Environment9()
+	if environment9 == nil {
+		return false
+	}
+	environment9.Release()
+	return true
+}
+
+// CreateContextMenuItem creates a custom item for the context menu which is currently requested. onSelected is
+// called when the user picks the item and may be nil, e.g. for separators and submenus. The returned item must be
+// released after it has been inserted into the menu.
+//
+// Returns ErrNotSupported if the installed runtime doesn't support custom context menu items.
+func (e *Chromium) CreateContextMenuItem(label string, kind COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND, onSelected func()) (*ICoreWebView2ContextMenuItem, error) {
+	environment9 := e.environment.GetICoreWebView2Environment9()
+	if environment9 == nil {
+		return nil, ErrNotSupported
+	}
+	defer environment9.Release()
+
+	item, err := environment9.CreateContextMenuItem(label, nil, kind)
+	if err != nil {
+		return nil, err
+	}
+	if onSelected == nil {
+		return item, nil
+	}
+
+	commandID, err := item.GetCommandId()
+	if err != nil {
+		item.Release()
+		return nil, err
+	}
+
+	var token _EventRegistrationToken
+	if err := item.AddCustomItemSelected(e.customItemSelected, &token); err != nil {
+		item.Release()
+		return nil, err
+	}
+	e.contextMenuCommands[commandID] = onSelected
+	return item, nil
+}
@@ -38,6 +38,12 @@ const (
 	CoreWebView2PermissionKindNotifications
 	CoreWebView2PermissionKindOtherSensors
 	CoreWebView2PermissionKindClipboardRead
+	CoreWebView2PermissionKindMultipleAutomaticDownloads
+	CoreWebView2PermissionKindFileReadWrite
+	CoreWebView2PermissionKindAutoplay
+	CoreWebView2PermissionKindLocalFonts
+	CoreWebView2PermissionKindMidiSystemExclusiveMessages
+	CoreWebView2PermissionKindWindowManagement
 )
 
 type CoreWebView2PermissionState uint32
@@ -207,6 +213,48 @@ func (i *ICoreWebView2) GetSettings() (*ICoreWebViewSettings, error) {
 	return settings, nil
 }
 
+// GetBrowserProcessID returns the process ID of the browser process hosting the webview.
+func (i *ICoreWebView2) GetBrowserProcessID() (uint32, error) {
+	var browserProcessID uint32
+	res, _, err := i.vtbl.GetBrowserProcessID.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&browserProcessID)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return 0, syscall.Errno(res)
+	}
+	return browserProcessID, nil
+}
+
+// CallDevToolsProtocolMethod calls a method of the DevTools protocol, e.g. "Page.captureScreenshot", with the
+// parameters encoded as JSON object.
+func (i *ICoreWebView2) CallDevToolsProtocolMethod(methodName, parametersAsJSON string, handler *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler) error {
+	_methodName, err := windows.UTF16PtrFromString(methodName)
+	if err != nil {
+		return err
+	}
+	_parametersAsJSON, err := windows.UTF16PtrFromString(parametersAsJSON)
+	if err != nil {
+		return err
+	}
+	res, _, err := i.vtbl.CallDevToolsProtocolMethod.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_methodName)),
+		uintptr(unsafe.Pointer(_parametersAsJSON)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return syscall.Errno(res)
+	}
+	return nil
+}
+
 // ICoreWebView2Environment
 
 type iCoreWebView2EnvironmentVtbl struct {
@@ -285,20 +333,60 @@ type iCoreWebView2WebMessageReceivedEventArgs struct {
 	vtbl *iCoreWebView2WebMessageReceivedEventArgsVtbl
 }
 
-// ICoreWebView2PermissionRequestedEventArgs
+func (i *iCoreWebView2WebMessageReceivedEventArgs) GetSource() (string, error) {
+	// Create *uint16 to hold result
+	var _source *uint16
+	res, _, err := i.vtbl.GetSource.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_source)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	source := windows.UTF16PtrToString(_source)
+	windows.CoTaskMemFree(unsafe.Pointer(_source))
+	return source, nil
+}
 
-type iCoreWebView2PermissionRequestedEventArgsVtbl struct {
-	_IUnknownVtbl
-	GetURI             ComProc
-	GetPermissionKind  ComProc
-	GetIsUserInitiated ComProc
-	GetState           ComProc
-	PutState           ComProc
-	GetDeferral        ComProc
+func (i *iCoreWebView2WebMessageReceivedEventArgs) GetWebMessageAsJSON() (string, error) {
+	// Create *uint16 to hold result
+	var _webMessageAsJSON *uint16
+	res, _, err := i.vtbl.GetWebMessageAsJSON.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_webMessageAsJSON)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	// Get result and cleanup
+	webMessageAsJSON := windows.UTF16PtrToString(_webMessageAsJSON)
+	windows.CoTaskMemFree(unsafe.Pointer(_webMessageAsJSON))
+	return webMessageAsJSON, nil
 }
 
-type iCoreWebView2PermissionRequestedEventArgs struct {
-	vtbl *iCoreWebView2PermissionRequestedEventArgsVtbl
+// TryGetWebMessageAsString returns an error if the message hasn't been posted as a string.
+func (i *iCoreWebView2WebMessageReceivedEventArgs) TryGetWebMessageAsString() (string, error) {
+	var _webMessageAsString *uint16
+	res, _, err := i.vtbl.TryGetWebMessageAsString.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_webMessageAsString)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	if windows.Handle(res) != windows.S_OK {
+		return "", syscall.Errno(res)
+	}
+	webMessageAsString := windows.UTF16PtrToString(_webMessageAsString)
+	windows.CoTaskMemFree(unsafe.Pointer(_webMessageAsString))
+	return webMessageAsString, nil
 }
 
 // ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler
@@ -403,7 +491,7 @@ func newICoreWebView2WebMessageReceivedEventHandler(impl iCoreWebView2WebMessage
 
 type iCoreWebView2PermissionRequestedEventHandlerImpl interface {
 	_IUnknownImpl
-	PermissionRequested(sender *ICoreWebView2, args *iCoreWebView2PermissionRequestedEventArgs) uintptr
+	PermissionRequested(sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs) uintptr
 }
 
 type iCoreWebView2PermissionRequestedEventHandlerVtbl struct {
@@ -428,7 +516,7 @@ func _ICoreWebView2PermissionRequestedEventHandlerIUnknownRelease(this *iCoreWeb
 	return this.impl.Release()
 }
 
-func _ICoreWebView2PermissionRequestedEventHandlerInvoke(this *iCoreWebView2PermissionRequestedEventHandler, sender *ICoreWebView2, args *iCoreWebView2PermissionRequestedEventArgs) uintptr {
+func _ICoreWebView2PermissionRequestedEventHandlerInvoke(this *iCoreWebView2PermissionRequestedEventHandler, sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs) uintptr {
 	return this.impl.PermissionRequested(sender, args)
 }
 
@@ -8,14 +8,14 @@ import (
 	"github.com/b1naryth1ef/wv2/webviewloader"
 )
 
-func createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataFolder string, environmentCompletedHandle *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler, additionalBrowserArgs string) error {
+func createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataFolder string, environmentCompletedHandle *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler, additionalBrowserArgs string, options *EnvironmentOptions) error {
 	e := &environmentCreatedHandler{environmentCompletedHandle}
-	return webviewloader.CreateCoreWebView2EnvironmentWithOptions(
-		e,
+	opts := append([]webviewloader.Option{
 		webviewloader.WithBrowserExecutableFolder(browserExecutableFolder),
 		webviewloader.WithUserDataFolder(userDataFolder),
 		webviewloader.WithAdditionalBrowserArguments(additionalBrowserArgs),
-	)
+	}, options.loaderOptions()...)
+	return webviewloader.CreateCoreWebView2EnvironmentWithOptions(e, opts...)
 }
 
 type environmentCreatedHandler struct {
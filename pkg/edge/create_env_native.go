@@ -12,7 +12,7 @@ import (
 	"golang.org/x/sys/windows"
 )
 
-func createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataFolder string, environmentCompletedHandle *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler, additionalBrowserArgs string) error {
+func createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataFolder string, environmentCompletedHandle *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler, additionalBrowserArgs string, options *EnvironmentOptions) error {
 	browserPathPtr, err := windows.UTF16PtrFromString(browserExecutableFolder)
 	if err != nil {
 		return fmt.Errorf("Error calling UTF16PtrFromString for %s: %v", browserExecutableFolder, err)
@@ -27,7 +27,7 @@ func createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataF
 		browserPathPtr,
 		userPathPtr,
 		uintptr(unsafe.Pointer(environmentCompletedHandle)),
-		additionalBrowserArgs,
+		append(options.loaderOptions(), webviewloader.WithAdditionalBrowserArguments(additionalBrowserArgs))...,
 	)
 
 	if hr != 0 {
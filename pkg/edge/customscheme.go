@@ -0,0 +1,17 @@
+//go:build windows
+
+package edge
+
+// CustomScheme is a scheme like app:// which is served by the application instead of the network.
+type CustomScheme struct {
+	// Name of the scheme without the colon, e.g. "app". It must be lower-case.
+	Name string
+	// TreatAsSecure makes pages of the scheme a secure context like https pages.
+	TreatAsSecure bool
+	// AllowedOrigins may issue requests for the scheme, e.g. "https://example.com" or "*" for all origins. Pages of
+	// the scheme itself are always allowed.
+	AllowedOrigins []string
+	// HasAuthorityComponent means URIs look like app://host/path, so pages of different hosts get different
+	// origins. Otherwise all pages of the scheme share an opaque origin.
+	HasAuthorityComponent bool
+}
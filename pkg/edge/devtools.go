@@ -0,0 +1,34 @@
+//go:build windows
+
+package edge
+
+import "golang.org/x/sys/windows"
+
+// CallDevToolsProtocolMethod calls a method of the DevTools protocol with the parameters encoded as JSON object.
+// completed is called on the UI thread with the result object, if the call failed it holds the error reported by
+// the browser.
+func (e *Chromium) CallDevToolsProtocolMethod(methodName, parametersAsJSON string, completed func(returnObjectAsJSON string, err error)) error {
+	c := &callDevToolsProtocolMethodCompleted{fn: completed}
+	c.handler = newICoreWebView2CallDevToolsProtocolMethodCompletedHandler(c)
+	keepAlive(c)
+	if err := e.webview.CallDevToolsProtocolMethod(methodName, parametersAsJSON, c.handler); err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+type callDevToolsProtocolMethodCompleted struct {
+	completionImpl
+	handler *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler
+	fn      func(returnObjectAsJSON string, err error)
+}
+
+func (c *callDevToolsProtocolMethodCompleted) CallDevToolsProtocolMethodCompleted(errorCode uintptr, returnObjectAsJSON *uint16) uintptr {
+	releaseKeepAlive(c)
+
+	if c.fn != nil {
+		c.fn(windows.UTF16PtrToString(returnObjectAsJSON), errorFromHRESULT(errorCode))
+	}
+	return 0
+}
@@ -0,0 +1,91 @@
+//go:build windows
+
+package edge
+
+import (
+	"sync/atomic"
+)
+
+var downloadIDs uint64
+
+// Download tracks a single ICoreWebView2DownloadOperation and forwards its change events to Go callbacks.
+// Downloads are kept alive by the Chromium that started them until they are completed or interrupted
+// without the possibility to resume.
+type Download struct {
+	ID        uint64
+	Operation *ICoreWebView2DownloadOperation
+
+	chromium             *Chromium
+	finished             bool
+	bytesReceivedChanged *ICoreWebView2BytesReceivedChangedEventHandler
+	stateChanged         *ICoreWebView2StateChangedEventHandler
+
+	// Callbacks
+	BytesReceivedChangedCallback func(download *Download)
+	StateChangedCallback         func(download *Download)
+}
+
+func newDownload(chromium *Chromium, operation *ICoreWebView2DownloadOperation) *Download {
+	d := &Download{
+		ID:        atomic.AddUint64(&downloadIDs, 1),
+		Operation: operation,
+		chromium:  chromium,
+	}
+	d.bytesReceivedChanged = newICoreWebView2BytesReceivedChangedEventHandler(d)
+	d.stateChanged = newICoreWebView2StateChangedEventHandler(d)
+
+	operation.AddRef()
+
+	var token _EventRegistrationToken
+	operation.AddBytesReceivedChanged(d.bytesReceivedChanged, &token)
+	operation.AddStateChanged(d.stateChanged, &token)
+	return d
+}
+
+// Finished reports if the download has completed or was interrupted without being resumable. The Operation
+// must not be used anymore after the download has finished.
+func (d *Download) Finished() bool {
+	return d.finished
+}
+
+// Discard releases a download which was cancelled in the DownloadStartingCallback, it never changes its state and
+// would be kept alive otherwise.
+func (d *Download) Discard() {
+	d.chromium.finishDownload(d)
+}
+
+func (d *Download) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (d *Download) AddRef() uintptr {
+	return 1
+}
+
+func (d *Download) Release() uintptr {
+	return 1
+}
+
+func (d *Download) BytesReceivedChanged(sender *ICoreWebView2DownloadOperation, _ uintptr) uintptr {
+	if d.BytesReceivedChangedCallback != nil {
+		d.BytesReceivedChangedCallback(d)
+	}
+	return 0
+}
+
+func (d *Download) StateChanged(sender *ICoreWebView2DownloadOperation, _ uintptr) uintptr {
+	if d.StateChangedCallback != nil {
+		d.StateChangedCallback(d)
+	}
+
+	state, _ := sender.GetState()
+	switch state {
+	case COREWEBVIEW2_DOWNLOAD_STATE_COMPLETED:
+		d.chromium.finishDownload(d)
+	case COREWEBVIEW2_DOWNLOAD_STATE_INTERRUPTED:
+		if canResume, _ := sender.GetCanResume(); !canResume {
+			d.chromium.finishDownload(d)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,164 @@
+//go:build windows
+
+package edge
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/b1naryth1ef/wv2/webviewloader"
+)
+
+// EnvironmentOptions are applied when the environment is created. All webviews sharing the data path must use the
+// same options, otherwise creating the later ones fails.
+type EnvironmentOptions struct {
+	// Language of the browser UI and of the Accept-Language header, e.g. "en-US". Defaults to the language of the OS.
+	Language string
+	// TargetCompatibleBrowserVersion is the oldest runtime version, e.g. "120.0.2210.55", the application works
+	// with.
+	TargetCompatibleBrowserVersion string
+	// AllowSingleSignOnUsingOSPrimaryAccount signs pages in with the Microsoft or Azure AD account of Windows.
+	AllowSingleSignOnUsingOSPrimaryAccount bool
+	// ExclusiveUserDataFolderAccess prevents other processes from using the data path at the same time.
+	ExclusiveUserDataFolderAccess bool
+	// CustomCrashReporting keeps crash dumps in the data path instead of sending them to Microsoft.
+	CustomCrashReporting bool
+	// DisableTrackingPrevention turns off the tracking prevention, which improves the performance when only content
+	// of the application is shown.
+	DisableTrackingPrevention bool
+	// BrowserExtensions allows to install browser extensions.
+	BrowserExtensions bool
+	// CustomSchemes are registered with the environment, their requests are answered through
+	// WebResourceRequestedCallback.
+	CustomSchemes []CustomScheme
+}
+
+// reservedSchemes are handled by the browser and can't be registered as custom schemes.
+var reservedSchemes = map[string]bool{
+	"http": true, "https": true, "ws": true, "wss": true, "ftp": true, "file": true, "data": true, "blob": true,
+	"about": true, "javascript": true, "filesystem": true, "edge": true, "chrome": true, "devtools": true,
+	"chrome-extension": true, "extension": true,
+}
+
+// Validate checks the options and their combination with additionalBrowserArgs before the environment is created,
+// creating it would fail later with less helpful errors or silently ignore an option otherwise.
+func (o *EnvironmentOptions) Validate(additionalBrowserArgs []string) error {
+	var errs []error
+
+	if o.Language != "" && !validLanguageTag(o.Language) {
+		errs = append(errs, fmt.Errorf("invalid language %q, expected a tag like en-US", o.Language))
+	}
+	if o.TargetCompatibleBrowserVersion != "" && !validBrowserVersion(o.TargetCompatibleBrowserVersion) {
+		errs = append(errs, fmt.Errorf("invalid target compatible browser version %q", o.TargetCompatibleBrowserVersion))
+	}
+
+	for _, arg := range additionalBrowserArgs {
+		name, _, _ := strings.Cut(arg, "=")
+		switch name {
+		case "--lang":
+			if o.Language != "" {
+				errs = append(errs, fmt.Errorf("browser argument %s conflicts with Language %q", arg, o.Language))
+			}
+		case "--user-data-dir":
+			errs = append(errs, fmt.Errorf("browser argument %s is ignored by WebView2, set the DataPath instead", arg))
+		case "--load-extension":
+			if !o.BrowserExtensions {
+				errs = append(errs, fmt.Errorf("browser argument %s requires BrowserExtensions", arg))
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, scheme := range o.CustomSchemes {
+		switch {
+		case !validSchemeName(scheme.Name):
+			errs = append(errs, fmt.Errorf("invalid custom scheme name %q, it must be lower-case without colon", scheme.Name))
+		case reservedSchemes[scheme.Name]:
+			errs = append(errs, fmt.Errorf("the scheme %q can't be registered as custom scheme", scheme.Name))
+		case seen[scheme.Name]:
+			errs = append(errs, fmt.Errorf("the custom scheme %q is registered twice", scheme.Name))
+		}
+		seen[scheme.Name] = true
+
+		for _, origin := range scheme.AllowedOrigins {
+			if origin == "*" {
+				continue
+			}
+			if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" || strings.Trim(u.Path, "/") != "" {
+				errs = append(errs, fmt.Errorf("invalid allowed origin %q of the custom scheme %q", origin, scheme.Name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (o *EnvironmentOptions) loaderOptions() []webviewloader.Option {
+	registrations := make([]webviewloader.CustomSchemeRegistration, len(o.CustomSchemes))
+	for i, scheme := range o.CustomSchemes {
+		registrations[i] = webviewloader.CustomSchemeRegistration{
+			SchemeName:            scheme.Name,
+			TreatAsSecure:         scheme.TreatAsSecure,
+			AllowedOrigins:        scheme.AllowedOrigins,
+			HasAuthorityComponent: scheme.HasAuthorityComponent,
+		}
+	}
+
+	return []webviewloader.Option{
+		webviewloader.WithLanguage(o.Language),
+		webviewloader.WithTargetCompatibleBrowserVersion(o.TargetCompatibleBrowserVersion),
+		webviewloader.WithAllowSingleSignOnUsingOSPrimaryAccount(o.AllowSingleSignOnUsingOSPrimaryAccount),
+		webviewloader.WithExclusiveUserDataFolderAccess(o.ExclusiveUserDataFolderAccess),
+		webviewloader.WithCustomCrashReporting(o.CustomCrashReporting),
+		webviewloader.WithTrackingPrevention(!o.DisableTrackingPrevention),
+		webviewloader.WithBrowserExtensions(o.BrowserExtensions),
+		webviewloader.WithCustomSchemeRegistrations(registrations...),
+	}
+}
+
+// validLanguageTag accepts BCP 47 like tags, e.g. "de" or "zh-Hant-TW".
+func validLanguageTag(tag string) bool {
+	for i, part := range strings.Split(tag, "-") {
+		if len(part) == 0 || len(part) > 8 || i == 0 && (len(part) < 2 || len(part) > 3) {
+			return false
+		}
+		for _, c := range part {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || i > 0 && c >= '0' && c <= '9') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validBrowserVersion accepts versions like "120.0.2210.55", optionally followed by a channel suffix.
+func validBrowserVersion(version string) bool {
+	version, _, _ = strings.Cut(version, " ")
+	parts := strings.Split(version, ".")
+	if len(parts) > 4 {
+		return false
+	}
+	for _, part := range parts {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func validSchemeName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '+' || c == '-' || c == '.'):
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,10 @@
+//go:build windows
+
+package edge
+
+func (e *Chromium) LaunchingExternalUriScheme(sender *ICoreWebView2, args *ICoreWebView2LaunchingExternalUriSchemeEventArgs) uintptr {
+	if e.LaunchingExternalUriSchemeCallback != nil {
+		e.LaunchingExternalUriSchemeCallback(sender, args)
+	}
+	return 0
+}
@@ -0,0 +1,235 @@
+//go:build windows
+
+package edge
+
+import (
+	"log"
+	"sync/atomic"
+
+	"golang.org/x/sys/windows"
+)
+
+var frameIDs uint64
+
+// Frame tracks a single ICoreWebView2Frame and forwards its events to Go callbacks. Frames are kept alive by the
+// Chromium until they are destroyed.
+type Frame struct {
+	ID    uint64
+	Frame *ICoreWebView2Frame
+
+	chromium            *Chromium
+	frame2              *ICoreWebView2Frame2
+	destroyed           bool
+	nameChanged         *ICoreWebView2FrameNameChangedEventHandler
+	frameDestroyed      *ICoreWebView2FrameDestroyedEventHandler
+	navigationStarting  *ICoreWebView2FrameNavigationStartingEventHandler
+	navigationCompleted *ICoreWebView2FrameNavigationCompletedEventHandler
+	webMessageReceived  *ICoreWebView2FrameWebMessageReceivedEventHandler
+
+	// Callbacks
+	NameChangedCallback         func(frame *Frame)
+	DestroyedCallback           func(frame *Frame)
+	NavigationStartingCallback  func(frame *Frame, args *ICoreWebView2NavigationStartingEventArgs)
+	NavigationCompletedCallback func(frame *Frame, args *ICoreWebView2NavigationCompletedEventArgs)
+	MessageCallback             func(frame *Frame, message string, source string)
+}
+
+func newFrame(chromium *Chromium, frame *ICoreWebView2Frame) *Frame {
+	f := &Frame{
+		ID:       atomic.AddUint64(&frameIDs, 1),
+		Frame:    frame,
+		chromium: chromium,
+	}
+	f.nameChanged = newICoreWebView2FrameNameChangedEventHandler(f)
+	f.frameDestroyed = newICoreWebView2FrameDestroyedEventHandler(f)
+	f.navigationStarting = newICoreWebView2FrameNavigationStartingEventHandler(f)
+	f.navigationCompleted = newICoreWebView2FrameNavigationCompletedEventHandler(f)
+	f.webMessageReceived = newICoreWebView2FrameWebMessageReceivedEventHandler(f)
+
+	frame.AddRef()
+
+	var token _EventRegistrationToken
+	frame.AddNameChanged(f.nameChanged, &token)
+	frame.AddDestroyed(f.frameDestroyed, &token)
+	if frame2 := frame.GetICoreWebView2Frame2(); frame2 != nil {
+		// Navigation events, scripts and messaging need ICoreWebView2Frame2, older runtimes only report the
+		// lifetime of frames.
+		f.frame2 = frame2
+		frame2.AddNavigationStarting(f.navigationStarting, &token)
+		frame2.AddNavigationCompleted(f.navigationCompleted, &token)
+		frame2.AddWebMessageReceived(f.webMessageReceived, &token)
+	}
+	return f
+}
+
+// Destroyed reports if the frame has been removed from the document. The Frame must not be used anymore after it
+// has been destroyed.
+func (f *Frame) Destroyed() bool {
+	return f.destroyed
+}
+
+func (f *Frame) Name() string {
+	if f.destroyed {
+		return ""
+	}
+	name, _ := f.Frame.GetName()
+	return name
+}
+
+// RuntimeID returns the ID the runtime assigned to the frame, it matches the frame IDs of ProcessInfo.
+func (f *Frame) RuntimeID() (uint32, error) {
+	if f.destroyed {
+		return 0, ErrFrameDestroyed
+	}
+	frame5 := f.Frame.GetICoreWebView2Frame5()
+	if frame5 == nil {
+		return 0, ErrNotSupported
+	}
+	defer frame5.Release()
+	return frame5.GetFrameId()
+}
+
+// ExecuteScript runs script in the frame. completed is called on the UI thread with the result of the script as
+// JSON and may be nil.
+func (f *Frame) ExecuteScript(script string, completed func(resultAsJSON string, err error)) error {
+	if f.destroyed {
+		return ErrFrameDestroyed
+	}
+	if f.frame2 == nil {
+		return ErrNotSupported
+	}
+	if completed == nil {
+		return f.frame2.ExecuteScript(script, nil)
+	}
+
+	c := &executeScriptCompleted{fn: completed}
+	c.handler = newICoreWebView2ExecuteScriptCompletedHandler(c)
+	keepAlive(c)
+	if err := f.frame2.ExecuteScript(script, c.handler); err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+// PostWebMessageAsJSON posts a message to the frame, it is received by listeners of the message event of
+// window.chrome.webview.
+func (f *Frame) PostWebMessageAsJSON(webMessageAsJSON string) error {
+	if f.destroyed {
+		return ErrFrameDestroyed
+	}
+	if f.frame2 == nil {
+		return ErrNotSupported
+	}
+	return f.frame2.PostWebMessageAsJSON(webMessageAsJSON)
+}
+
+func (f *Frame) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (f *Frame) AddRef() uintptr {
+	return 1
+}
+
+func (f *Frame) Release() uintptr {
+	return 1
+}
+
+func (f *Frame) FrameNameChanged(sender *ICoreWebView2Frame, _ uintptr) uintptr {
+	if f.NameChangedCallback != nil {
+		f.NameChangedCallback(f)
+	}
+	return 0
+}
+
+func (f *Frame) FrameDestroyed(sender *ICoreWebView2Frame, _ uintptr) uintptr {
+	f.chromium.destroyFrame(f)
+	if f.DestroyedCallback != nil {
+		f.DestroyedCallback(f)
+	}
+	return 0
+}
+
+func (f *Frame) FrameNavigationStarting(sender *ICoreWebView2Frame, args *ICoreWebView2NavigationStartingEventArgs) uintptr {
+	if f.NavigationStartingCallback != nil {
+		f.NavigationStartingCallback(f, args)
+	}
+	return 0
+}
+
+func (f *Frame) FrameNavigationCompleted(sender *ICoreWebView2Frame, args *ICoreWebView2NavigationCompletedEventArgs) uintptr {
+	if f.NavigationCompletedCallback != nil {
+		f.NavigationCompletedCallback(f, args)
+	}
+	return 0
+}
+
+func (f *Frame) FrameWebMessageReceived(sender *ICoreWebView2Frame, args *iCoreWebView2WebMessageReceivedEventArgs) uintptr {
+	if f.MessageCallback == nil {
+		return 0
+	}
+
+	source, err := args.GetSource()
+	if err != nil {
+		log.Printf("GetSource failed: %v", err)
+		return 0
+	}
+	message, err := args.TryGetWebMessageAsString()
+	if err != nil {
+		// Messages which have not been posted as string are passed on as JSON.
+		if message, err = args.GetWebMessageAsJSON(); err != nil {
+			log.Printf("GetWebMessageAsJSON failed: %v", err)
+			return 0
+		}
+	}
+	f.MessageCallback(f, message, source)
+	return 0
+}
+
+func (e *Chromium) FrameCreated(sender *ICoreWebView2, args *ICoreWebView2FrameCreatedEventArgs) uintptr {
+	frame, err := args.GetFrame()
+	if err != nil {
+		log.Printf("GetFrame failed: %v", err)
+		return 0
+	}
+
+	f := newFrame(e, frame)
+	e.frames[f.ID] = f
+
+	if e.FrameCreatedCallback != nil {
+		e.FrameCreatedCallback(f)
+	}
+	return 0
+}
+
+// GetFrame returns the frame with the specified id or nil if it is unknown or already destroyed.
+func (e *Chromium) GetFrame(id uint64) *Frame {
+	return e.frames[id]
+}
+
+func (e *Chromium) destroyFrame(frame *Frame) {
+	if _, found := e.frames[frame.ID]; !found {
+		return
+	}
+	delete(e.frames, frame.ID)
+	frame.destroyed = true
+	if frame.frame2 != nil {
+		frame.frame2.Release()
+		frame.frame2 = nil
+	}
+	frame.Frame.Release()
+}
+
+type executeScriptCompleted struct {
+	completionImpl
+	handler *ICoreWebView2ExecuteScriptCompletedHandler
+	fn      func(resultAsJSON string, err error)
+}
+
+func (c *executeScriptCompleted) ExecuteScriptCompleted(errorCode uintptr, resultObjectAsJSON *uint16) uintptr {
+	releaseKeepAlive(c)
+
+	c.fn(windows.UTF16PtrToString(resultObjectAsJSON), errorFromHRESULT(errorCode))
+	return 0
+}
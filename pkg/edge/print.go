@@ -0,0 +1,291 @@
+//go:build windows
+
+package edge
+
+import (
+	"errors"
+	"io"
+)
+
+// PrintSettings describes how a page gets printed. The zero value uses the defaults of the runtime.
+type PrintSettings struct {
+	Orientation COREWEBVIEW2_PRINT_ORIENTATION
+
+	// ScaleFactor must be between 0.1 and 2.0, zero uses the default of 1.0.
+	ScaleFactor float64
+
+	// PageWidth and PageHeight are in inches, zero uses the default letter size.
+	PageWidth  float64
+	PageHeight float64
+
+	// Margins are in inches, nil uses the default margins of 0.4 inches.
+	Margins *PrintMargins
+
+	PrintBackgrounds     bool
+	PrintSelectionOnly   bool
+	PrintHeaderAndFooter bool
+	HeaderTitle          string
+	FooterURI            string
+
+	// PageRanges, e.g. "1-3, 5", PrinterName and Copies need ICoreWebView2PrintSettings2 and are ignored when
+	// printing to PDF.
+	PageRanges  string
+	PrinterName string
+	Copies      int
+}
+
+type PrintMargins struct {
+	Top, Bottom, Left, Right float64
+}
+
+// CreatePrintSettings creates the native print settings for settings. It returns nil if settings is nil which makes
+// the runtime use its defaults. The returned settings must be released after finishing using them.
+func (e *Chromium) CreatePrintSettings(settings *PrintSettings) (*ICoreWebView2PrintSettings, error) {
+	if settings == nil {
+		return nil, nil
+	}
+
+	env6 := e.environment.GetICoreWebView2Environment6()
+	if env6 == nil {
+		return nil, ErrNotSupported
+	}
+	defer env6.Release()
+
+	s, err := env6.CreatePrintSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyPrintSettings(s, settings); err != nil {
+		s.Release()
+		return nil, err
+	}
+	return s, nil
+}
+
+func applyPrintSettings(s *ICoreWebView2PrintSettings, settings *PrintSettings) error {
+	if err := s.PutOrientation(settings.Orientation); err != nil {
+		return err
+	}
+	if settings.ScaleFactor != 0 {
+		if err := s.PutScaleFactor(settings.ScaleFactor); err != nil {
+			return err
+		}
+	}
+	if settings.PageWidth != 0 {
+		if err := s.PutPageWidth(settings.PageWidth); err != nil {
+			return err
+		}
+	}
+	if settings.PageHeight != 0 {
+		if err := s.PutPageHeight(settings.PageHeight); err != nil {
+			return err
+		}
+	}
+	if m := settings.Margins; m != nil {
+		for _, put := range []func() error{
+			func() error { return s.PutMarginTop(m.Top) },
+			func() error { return s.PutMarginBottom(m.Bottom) },
+			func() error { return s.PutMarginLeft(m.Left) },
+			func() error { return s.PutMarginRight(m.Right) },
+		} {
+			if err := put(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := s.PutShouldPrintBackgrounds(settings.PrintBackgrounds); err != nil {
+		return err
+	}
+	if err := s.PutShouldPrintSelectionOnly(settings.PrintSelectionOnly); err != nil {
+		return err
+	}
+	if err := s.PutShouldPrintHeaderAndFooter(settings.PrintHeaderAndFooter); err != nil {
+		return err
+	}
+	if settings.HeaderTitle != "" {
+		if err := s.PutHeaderTitle(settings.HeaderTitle); err != nil {
+			return err
+		}
+	}
+	if settings.FooterURI != "" {
+		if err := s.PutFooterUri(settings.FooterURI); err != nil {
+			return err
+		}
+	}
+
+	if settings.PageRanges == "" && settings.PrinterName == "" && settings.Copies == 0 {
+		return nil
+	}
+
+	s2 := s.GetICoreWebView2PrintSettings2()
+	if s2 == nil {
+		return ErrNotSupported
+	}
+	defer s2.Release()
+
+	if settings.PageRanges != "" {
+		if err := s2.PutPageRanges(settings.PageRanges); err != nil {
+			return err
+		}
+	}
+	if settings.PrinterName != "" {
+		if err := s2.PutPrinterName(settings.PrinterName); err != nil {
+			return err
+		}
+	}
+	if settings.Copies != 0 {
+		if err := s2.PutCopies(int32(settings.Copies)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintToPdf prints the current page to a PDF file at path. completed is called on the UI thread when printing
+// has finished.
+func (e *Chromium) PrintToPdf(path string, settings *PrintSettings, completed func(err error)) error {
+	webview7 := e.webview.GetICoreWebView2_7()
+	if webview7 == nil {
+		return ErrNotSupported
+	}
+	defer webview7.Release()
+
+	s, err := e.CreatePrintSettings(settings)
+	if err != nil {
+		return err
+	}
+	if s != nil {
+		defer s.Release()
+	}
+
+	c := &printToPdfCompleted{fn: completed}
+	c.handler = newICoreWebView2PrintToPdfCompletedHandler(c)
+	keepAlive(c)
+	if err := webview7.PrintToPdf(path, s, c.handler); err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+// PrintToPdfStream prints the current page to a PDF in memory. completed is called on the UI thread with the
+// content of the PDF when printing has finished.
+func (e *Chromium) PrintToPdfStream(settings *PrintSettings, completed func(pdf []byte, err error)) error {
+	webview16 := e.webview.GetICoreWebView2_16()
+	if webview16 == nil {
+		return ErrNotSupported
+	}
+	defer webview16.Release()
+
+	s, err := e.CreatePrintSettings(settings)
+	if err != nil {
+		return err
+	}
+	if s != nil {
+		defer s.Release()
+	}
+
+	c := &printToPdfStreamCompleted{fn: completed}
+	c.handler = newICoreWebView2PrintToPdfStreamCompletedHandler(c)
+	keepAlive(c)
+	if err := webview16.PrintToPdfStream(s, c.handler); err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+// Print prints the current page without showing a dialog. completed is called on the UI thread when the print job
+// has been handed to the printer.
+func (e *Chromium) Print(settings *PrintSettings, completed func(status COREWEBVIEW2_PRINT_STATUS, err error)) error {
+	webview16 := e.webview.GetICoreWebView2_16()
+	if webview16 == nil {
+		return ErrNotSupported
+	}
+	defer webview16.Release()
+
+	s, err := e.CreatePrintSettings(settings)
+	if err != nil {
+		return err
+	}
+	if s != nil {
+		defer s.Release()
+	}
+
+	c := &printCompleted{fn: completed}
+	c.handler = newICoreWebView2PrintCompletedHandler(c)
+	keepAlive(c)
+	if err := webview16.Print(s, c.handler); err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+// ShowPrintUI opens the browser or system print dialog for the current page.
+func (e *Chromium) ShowPrintUI(kind COREWEBVIEW2_PRINT_DIALOG_KIND) error {
+	webview16 := e.webview.GetICoreWebView2_16()
+	if webview16 == nil {
+		return ErrNotSupported
+	}
+	defer webview16.Release()
+	return webview16.ShowPrintUI(kind)
+}
+
+type printToPdfCompleted struct {
+	completionImpl
+	handler *ICoreWebView2PrintToPdfCompletedHandler
+	fn      func(err error)
+}
+
+func (c *printToPdfCompleted) PrintToPdfCompleted(errorCode uintptr, isSuccessful uintptr) uintptr {
+	releaseKeepAlive(c)
+
+	err := errorFromHRESULT(errorCode)
+	if err == nil && isSuccessful == 0 {
+		err = errors.New("printing to PDF failed")
+	}
+	if c.fn != nil {
+		c.fn(err)
+	}
+	return 0
+}
+
+type printToPdfStreamCompleted struct {
+	completionImpl
+	handler *ICoreWebView2PrintToPdfStreamCompletedHandler
+	fn      func(pdf []byte, err error)
+}
+
+func (c *printToPdfStreamCompleted) PrintToPdfStreamCompleted(errorCode uintptr, pdfStream *IStream) uintptr {
+	releaseKeepAlive(c)
+
+	var pdf []byte
+	err := errorFromHRESULT(errorCode)
+	if err == nil && pdfStream == nil {
+		err = errors.New("printing to PDF failed")
+	}
+	if err == nil {
+		pdf, err = io.ReadAll(pdfStream)
+	}
+	if c.fn != nil {
+		c.fn(pdf, err)
+	}
+	return 0
+}
+
+type printCompleted struct {
+	completionImpl
+	handler *ICoreWebView2PrintCompletedHandler
+	fn      func(status COREWEBVIEW2_PRINT_STATUS, err error)
+}
+
+func (c *printCompleted) PrintCompleted(errorCode uintptr, printStatus COREWEBVIEW2_PRINT_STATUS) uintptr {
+	releaseKeepAlive(c)
+
+	if c.fn != nil {
+		c.fn(printStatus, errorFromHRESULT(errorCode))
+	}
+	return 0
+}
@@ -0,0 +1,219 @@
+//go:build windows
+
+package edge
+
+import "errors"
+
+// ProcessInfo describes one of the processes of the browser.
+type ProcessInfo struct {
+	ProcessID uint32
+	Kind      COREWEBVIEW2_PROCESS_KIND
+	// Frames rendered by the process, only reported by ProcessExtendedInfos for renderer processes.
+	Frames []ProcessFrameInfo
+}
+
+// ProcessFrameInfo describes a frame rendered by a renderer process.
+type ProcessFrameInfo struct {
+	// ID is assigned by the runtime, see Frame.RuntimeID and MainFrameID. It is 0 if the runtime doesn't report it.
+	ID     uint32
+	Name   string
+	Source string
+}
+
+// BrowserProcessID returns the process ID of the browser process.
+func (e *Chromium) BrowserProcessID() (uint32, error) {
+	return e.webview.GetBrowserProcessID()
+}
+
+// MainFrameID returns the runtime ID of the main frame, see ProcessFrameInfo.ID.
+func (e *Chromium) MainFrameID() (uint32, error) {
+	webview20 := e.webview.GetICoreWebView2_20()
+	if webview20 == nil {
+		return 0, ErrNotSupported
+	}
+	defer webview20.Release()
+	return webview20.GetFrameId()
+}
+
+// ProcessInfos returns the processes of the browser without their frames. ProcessInfosChangedCallback is called
+// when processes are started or exit.
+func (e *Chromium) ProcessInfos() ([]ProcessInfo, error) {
+	env8 := e.environment.GetICoreWebView2Environment8()
+	if env8 == nil {
+		return nil, ErrNotSupported
+	}
+	defer env8.Release()
+	collection, err := env8.GetProcessInfos()
+	if err != nil {
+		return nil, err
+	}
+	defer collection.Release()
+
+	count, err := collection.GetCount()
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]ProcessInfo, 0, count)
+	for i := uint32(0); i < count; i++ {
+		processInfo, err := collection.GetValueAtIndex(i)
+		if err != nil {
+			return nil, err
+		}
+		info, err := readProcessInfo(processInfo)
+		processInfo.Release()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// ProcessExtendedInfos returns the processes of the browser together with the frames rendered by them. completed
+// is called on the UI thread.
+func (e *Chromium) ProcessExtendedInfos(completed func(infos []ProcessInfo, err error)) error {
+	env13 := e.environment.GetICoreWebView2Environment13()
+	if env13 == nil {
+		return ErrNotSupported
+	}
+	defer env13.Release()
+
+	c := &getProcessExtendedInfosCompleted{fn: completed}
+	c.handler = newICoreWebView2GetProcessExtendedInfosCompletedHandler(c)
+	keepAlive(c)
+	if err := env13.GetProcessExtendedInfos(c.handler); err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+type getProcessExtendedInfosCompleted struct {
+	completionImpl
+	handler *ICoreWebView2GetProcessExtendedInfosCompletedHandler
+	fn      func(infos []ProcessInfo, err error)
+}
+
+func (c *getProcessExtendedInfosCompleted) GetProcessExtendedInfosCompleted(errorCode uintptr, result *ICoreWebView2ProcessExtendedInfoCollection) uintptr {
+	releaseKeepAlive(c)
+
+	if c.fn == nil {
+		return 0
+	}
+	if err := errorFromHRESULT(errorCode); err != nil {
+		c.fn(nil, err)
+		return 0
+	}
+	c.fn(readProcessExtendedInfos(result))
+	return 0
+}
+
+func readProcessExtendedInfos(collection *ICoreWebView2ProcessExtendedInfoCollection) ([]ProcessInfo, error) {
+	if collection == nil {
+		return nil, errors.New("no process infos have been returned")
+	}
+	count, err := collection.GetCount()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]ProcessInfo, 0, count)
+	for i := uint32(0); i < count; i++ {
+		extendedInfo, err := collection.GetValueAtIndex(i)
+		if err != nil {
+			return nil, err
+		}
+		info, err := readProcessExtendedInfo(extendedInfo)
+		extendedInfo.Release()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func readProcessExtendedInfo(extendedInfo *ICoreWebView2ProcessExtendedInfo) (ProcessInfo, error) {
+	processInfo, err := extendedInfo.GetProcessInfo()
+	if err != nil {
+		return ProcessInfo{}, err
+	}
+	info, err := readProcessInfo(processInfo)
+	processInfo.Release()
+	if err != nil {
+		return ProcessInfo{}, err
+	}
+
+	frameInfos, err := extendedInfo.GetAssociatedFrameInfos()
+	if err != nil {
+		return ProcessInfo{}, err
+	}
+	defer frameInfos.Release()
+	iterator, err := frameInfos.GetIterator()
+	if err != nil {
+		return ProcessInfo{}, err
+	}
+	defer iterator.Release()
+
+	for {
+		hasCurrent, err := iterator.GetHasCurrent()
+		if err != nil {
+			return ProcessInfo{}, err
+		}
+		if !hasCurrent {
+			return info, nil
+		}
+		frameInfo, err := iterator.GetCurrent()
+		if err != nil {
+			return ProcessInfo{}, err
+		}
+		frame, err := readFrameInfo(frameInfo)
+		frameInfo.Release()
+		if err != nil {
+			return ProcessInfo{}, err
+		}
+		info.Frames = append(info.Frames, frame)
+
+		if _, err := iterator.MoveNext(); err != nil {
+			return ProcessInfo{}, err
+		}
+	}
+}
+
+func readProcessInfo(processInfo *ICoreWebView2ProcessInfo) (ProcessInfo, error) {
+	pid, err := processInfo.GetProcessId()
+	if err != nil {
+		return ProcessInfo{}, err
+	}
+	kind, err := processInfo.GetKind()
+	if err != nil {
+		return ProcessInfo{}, err
+	}
+	return ProcessInfo{ProcessID: uint32(pid), Kind: kind}, nil
+}
+
+func readFrameInfo(frameInfo *ICoreWebView2FrameInfo) (ProcessFrameInfo, error) {
+	var frame ProcessFrameInfo
+	var err error
+	if frame.Name, err = frameInfo.GetName(); err != nil {
+		return ProcessFrameInfo{}, err
+	}
+	if frame.Source, err = frameInfo.GetSource(); err != nil {
+		return ProcessFrameInfo{}, err
+	}
+	if frameInfo2 := frameInfo.GetICoreWebView2FrameInfo2(); frameInfo2 != nil {
+		frame.ID, err = frameInfo2.GetFrameId()
+		frameInfo2.Release()
+		if err != nil {
+			return ProcessFrameInfo{}, err
+		}
+	}
+	return frame, nil
+}
+
+func (e *Chromium) ProcessInfosChanged(sender *ICoreWebView2Environment, args uintptr) uintptr {
+	if e.ProcessInfosChangedCallback != nil {
+		e.ProcessInfosChangedCallback()
+	}
+	return 0
+}
@@ -0,0 +1,116 @@
+//go:build windows
+
+package edge
+
+import (
+	"time"
+)
+
+func (e *Chromium) createControllerWithOptions() error {
+	environment10 := e.environment.GetICoreWebView2Environment10()
+	if environment10 == nil {
+		return ErrNotSupported
+	}
+	defer environment10.Release()
+
+	options, err := environment10.CreateCoreWebView2ControllerOptions()
+	if err != nil {
+		return err
+	}
+	defer options.Release()
+
+	if err := options.PutProfileName(e.ProfileName); err != nil {
+		return err
+	}
+	if err := options.PutIsInPrivateModeEnabled(e.InPrivate); err != nil {
+		return err
+	}
+	return environment10.CreateCoreWebView2ControllerWithOptions(e.hwnd, options, e.controllerCompleted)
+}
+
+// GetProfile returns the profile of the webview, it must be released after finishing using it.
+func (e *Chromium) GetProfile() (*ICoreWebView2Profile, error) {
+	webview13 := e.webview.GetICoreWebView2_13()
+	if webview13 == nil {
+		return nil, ErrNotSupported
+	}
+	defer webview13.Release()
+	return webview13.GetProfile()
+}
+
+// PutPreferredColorScheme overrides the prefers-color-scheme of all pages of the profile.
+func (e *Chromium) PutPreferredColorScheme(scheme COREWEBVIEW2_PREFERRED_COLOR_SCHEME) error {
+	profile, err := e.GetProfile()
+	if err != nil {
+		return err
+	}
+	defer profile.Release()
+	return profile.PutPreferredColorScheme(scheme)
+}
+
+// PutDefaultDownloadFolderPath sets the folder downloads of the profile are saved to.
+func (e *Chromium) PutDefaultDownloadFolderPath(path string) error {
+	profile, err := e.GetProfile()
+	if err != nil {
+		return err
+	}
+	defer profile.Release()
+	return profile.PutDefaultDownloadFolderPath(path)
+}
+
+// ClearBrowsingData deletes the browsing data of the specified kinds of the profile. If from and to are zero all
+// data is deleted, otherwise only the data stored in between. completed is called on the UI thread.
+func (e *Chromium) ClearBrowsingData(dataKinds COREWEBVIEW2_BROWSING_DATA_KINDS, from, to time.Time, completed func(err error)) error {
+	profile, err := e.GetProfile()
+	if err != nil {
+		return err
+	}
+	defer profile.Release()
+
+	profile2 := profile.GetICoreWebView2Profile2()
+	if profile2 == nil {
+		return ErrNotSupported
+	}
+	defer profile2.Release()
+
+	c := &clearBrowsingDataCompleted{fn: completed}
+	c.handler = newICoreWebView2ClearBrowsingDataCompletedHandler(c)
+	keepAlive(c)
+	if from.IsZero() && to.IsZero() {
+		err = profile2.ClearBrowsingData(dataKinds, c.handler)
+	} else {
+		if to.IsZero() {
+			to = time.Now()
+		}
+		err = profile2.ClearBrowsingDataInTimeRange(dataKinds, secondsSinceEpoch(from), secondsSinceEpoch(to), c.handler)
+	}
+	if err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+// secondsSinceEpoch converts t to the representation WebView2 uses for dates, the zero time is the epoch. UnixNano
+// would overflow for dates outside of the years 1678 to 2262.
+func secondsSinceEpoch(t time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+	return float64(t.Unix()) + float64(t.Nanosecond())/1e9
+}
+
+type clearBrowsingDataCompleted struct {
+	completionImpl
+	handler *ICoreWebView2ClearBrowsingDataCompletedHandler
+	fn      func(err error)
+}
+
+func (c *clearBrowsingDataCompleted) ClearBrowsingDataCompleted(errorCode uintptr) uintptr {
+	releaseKeepAlive(c)
+
+	if c.fn != nil {
+		c.fn(errorFromHRESULT(errorCode))
+	}
+	return 0
+}
@@ -0,0 +1,134 @@
+//go:build windows
+
+package edge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RemoteDebugging exposes the DevTools protocol of the browser to external tools like Playwright or Puppeteer.
+// Only the TCP transport is supported, --remote-debugging-pipe needs handles inherited from the process starting the
+// browser, which is the WebView2 runtime and not the application.
+type RemoteDebugging struct {
+	// Port the browser listens on at 127.0.0.1, the browser chooses a free port if it is 0.
+	Port int
+	// Force enables remote debugging although Chromium.Debug isn't set. Everyone who can connect to the port can
+	// control the browser, so it should never be forced in release builds.
+	Force bool
+}
+
+var (
+	// ErrRemoteDebuggingRefused is returned if remote debugging is requested without Chromium.Debug or Force.
+	ErrRemoteDebuggingRefused = errors.New("remote debugging is only enabled for debug builds unless forced")
+	// ErrRemoteDebuggingDisabled is returned by RemoteDebuggingURL if remote debugging hasn't been enabled.
+	ErrRemoteDebuggingDisabled = errors.New("remote debugging is disabled")
+)
+
+// devToolsActivePortFile is written by the browser into its user data directory, which is the EBWebView folder
+// inside of the user data folder of the environment.
+var devToolsActivePortFile = filepath.Join("EBWebView", "DevToolsActivePort")
+
+// remoteDebuggingArgs returns the browser arguments enabling remote debugging.
+func (e *Chromium) remoteDebuggingArgs() ([]string, error) {
+	r := e.RemoteDebugging
+	if !e.Debug && !r.Force {
+		return nil, ErrRemoteDebuggingRefused
+	}
+	if r.Port < 0 || r.Port > 65535 {
+		return nil, fmt.Errorf("invalid remote debugging port %d", r.Port)
+	}
+	for _, arg := range e.AdditionalBrowserArgs {
+		name, _, _ := strings.Cut(arg, "=")
+		if name == "--remote-debugging-port" || name == "--remote-debugging-pipe" {
+			return nil, fmt.Errorf("%s conflicts with RemoteDebugging", name)
+		}
+	}
+	return []string{"--remote-debugging-port=" + strconv.Itoa(r.Port)}, nil
+}
+
+// RemoteDebuggingPort returns the port the browser listens on for DevTools protocol connections. It is read from
+// the DevToolsActivePort file, which might be left over from a previous run until the browser has started.
+func (e *Chromium) RemoteDebuggingPort() (int, error) {
+	if !e.remoteDebugging {
+		return 0, ErrRemoteDebuggingDisabled
+	}
+	if e.RemoteDebugging.Port != 0 {
+		return e.RemoteDebugging.Port, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(e.userDataFolder, devToolsActivePortFile))
+	if err != nil {
+		return 0, err
+	}
+	line, _, _ := strings.Cut(string(data), "\n")
+	port, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil || port <= 0 || port > 65535 {
+		return 0, fmt.Errorf("invalid DevToolsActivePort file: %q", data)
+	}
+	return port, nil
+}
+
+// RemoteDebuggingURL waits until the browser accepts DevTools protocol connections and returns the websocket URL of
+// the browser target, e.g. for Playwright's connectOverCDP. It may be called from any goroutine.
+func (e *Chromium) RemoteDebuggingURL(ctx context.Context) (string, error) {
+	if !e.remoteDebugging {
+		return "", ErrRemoteDebuggingDisabled
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		url, err := e.queryRemoteDebuggingURL(ctx)
+		if err == nil {
+			return url, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("%w: %v", ctx.Err(), err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// queryRemoteDebuggingURL asks the browser for the websocket URL instead of building it from the
+// DevToolsActivePort file, this also detects files left over from previous runs.
+func (e *Chromium) queryRemoteDebuggingURL(ctx context.Context) (string, error) {
+	port, err := e.RemoteDebuggingPort()
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://127.0.0.1:%d/json/version", port), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("querying the DevTools version failed: %s", resp.Status)
+	}
+
+	var version struct {
+		WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return "", err
+	}
+	if version.WebSocketDebuggerURL == "" {
+		return "", errors.New("the browser didn't report a websocket URL")
+	}
+	return version.WebSocketDebuggerURL, nil
+}
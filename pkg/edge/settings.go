@@ -0,0 +1,247 @@
+//go:build windows
+
+package edge
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Settings of the webview. Settings added by newer runtimes are only applied if the installed runtime supports them,
+// see PutSettings.
+type Settings struct {
+	ScriptEnabled               bool
+	WebMessageEnabled           bool
+	DefaultScriptDialogsEnabled bool
+	StatusBarEnabled            bool
+	DevToolsEnabled             bool
+	DefaultContextMenusEnabled  bool
+	HostObjectsAllowed          bool
+	ZoomControlEnabled          bool
+	BuiltInErrorPageEnabled     bool
+
+	// UserAgent replaces the user agent of the browser, the default one is kept if it is empty.
+	UserAgent string
+	// BrowserAcceleratorKeysEnabled allows keys like Ctrl+F or F5 to trigger browser features.
+	BrowserAcceleratorKeysEnabled bool
+	// PasswordAutosaveEnabled offers to save passwords entered into forms.
+	PasswordAutosaveEnabled bool
+	// GeneralAutofillEnabled suggests previously entered data like addresses in forms.
+	GeneralAutofillEnabled bool
+	PinchZoomEnabled       bool
+	SwipeNavigationEnabled bool
+	// HiddenPdfToolbarItems removes buttons from the toolbar of the PDF viewer.
+	HiddenPdfToolbarItems COREWEBVIEW2_PDF_TOOLBAR_ITEMS
+	// ReputationCheckingRequired enables SmartScreen, which warns of phishing and malware sites.
+	ReputationCheckingRequired bool
+	// NonClientRegionSupportEnabled allows pages to use the app-region CSS property to mark draggable regions.
+	NonClientRegionSupportEnabled bool
+}
+
+// DefaultSettings returns the settings a new webview starts with.
+func DefaultSettings() Settings {
+	return Settings{
+		ScriptEnabled:                 true,
+		WebMessageEnabled:             true,
+		DefaultScriptDialogsEnabled:   true,
+		StatusBarEnabled:              true,
+		DevToolsEnabled:               true,
+		DefaultContextMenusEnabled:    true,
+		HostObjectsAllowed:            true,
+		ZoomControlEnabled:            true,
+		BuiltInErrorPageEnabled:       true,
+		BrowserAcceleratorKeysEnabled: true,
+		GeneralAutofillEnabled:        true,
+		PinchZoomEnabled:              true,
+		SwipeNavigationEnabled:        true,
+		ReputationCheckingRequired:    true,
+	}
+}
+
+// boolSetting maps a field of Settings to the methods of the ICoreWebView2SettingsN interface introducing it.
+type boolSetting struct {
+	name    string
+	version int
+	field   func(*Settings) *bool
+	get     func(*ICoreWebViewSettings) (bool, error)
+	put     func(*ICoreWebViewSettings, bool) error
+}
+
+var boolSettings = []boolSetting{
+	{"ScriptEnabled", 1, func(s *Settings) *bool { return &s.ScriptEnabled },
+		(*ICoreWebViewSettings).GetIsScriptEnabled, (*ICoreWebViewSettings).PutIsScriptEnabled},
+	{"WebMessageEnabled", 1, func(s *Settings) *bool { return &s.WebMessageEnabled },
+		(*ICoreWebViewSettings).GetIsWebMessageEnabled, (*ICoreWebViewSettings).PutIsWebMessageEnabled},
+	{"DefaultScriptDialogsEnabled", 1, func(s *Settings) *bool { return &s.DefaultScriptDialogsEnabled },
+		(*ICoreWebViewSettings).GetAreDefaultScriptDialogsEnabled, (*ICoreWebViewSettings).PutAreDefaultScriptDialogsEnabled},
+	{"StatusBarEnabled", 1, func(s *Settings) *bool { return &s.StatusBarEnabled },
+		(*ICoreWebViewSettings).GetIsStatusBarEnabled, (*ICoreWebViewSettings).PutIsStatusBarEnabled},
+	{"DevToolsEnabled", 1, func(s *Settings) *bool { return &s.DevToolsEnabled },
+		(*ICoreWebViewSettings).GetAreDevToolsEnabled, (*ICoreWebViewSettings).PutAreDevToolsEnabled},
+	{"DefaultContextMenusEnabled", 1, func(s *Settings) *bool { return &s.DefaultContextMenusEnabled },
+		(*ICoreWebViewSettings).GetAreDefaultContextMenusEnabled, (*ICoreWebViewSettings).PutAreDefaultContextMenusEnabled},
+	{"HostObjectsAllowed", 1, func(s *Settings) *bool { return &s.HostObjectsAllowed },
+		(*ICoreWebViewSettings).GetAreHostObjectsAllowed, (*ICoreWebViewSettings).PutAreHostObjectsAllowed},
+	{"ZoomControlEnabled", 1, func(s *Settings) *bool { return &s.ZoomControlEnabled },
+		(*ICoreWebViewSettings).GetIsZoomControlEnabled, (*ICoreWebViewSettings).PutIsZoomControlEnabled},
+	{"BuiltInErrorPageEnabled", 1, func(s *Settings) *bool { return &s.BuiltInErrorPageEnabled },
+		(*ICoreWebViewSettings).GetIsBuiltInErrorPageEnabled, (*ICoreWebViewSettings).PutIsBuiltInErrorPageEnabled},
+	{"BrowserAcceleratorKeysEnabled", 3, func(s *Settings) *bool { return &s.BrowserAcceleratorKeysEnabled },
+		(*ICoreWebViewSettings).GetAreBrowserAcceleratorKeysEnabled, (*ICoreWebViewSettings).PutAreBrowserAcceleratorKeysEnabled},
+	{"PasswordAutosaveEnabled", 4, func(s *Settings) *bool { return &s.PasswordAutosaveEnabled },
+		(*ICoreWebViewSettings).GetIsPasswordAutosaveEnabled, (*ICoreWebViewSettings).PutIsPasswordAutosaveEnabled},
+	{"GeneralAutofillEnabled", 4, func(s *Settings) *bool { return &s.GeneralAutofillEnabled },
+		(*ICoreWebViewSettings).GetIsGeneralAutofillEnabled, (*ICoreWebViewSettings).PutIsGeneralAutofillEnabled},
+	{"PinchZoomEnabled", 5, func(s *Settings) *bool { return &s.PinchZoomEnabled },
+		(*ICoreWebViewSettings).GetIsPinchZoomEnabled, (*ICoreWebViewSettings).PutIsPinchZoomEnabled},
+	{"SwipeNavigationEnabled", 6, func(s *Settings) *bool { return &s.SwipeNavigationEnabled },
+		(*ICoreWebViewSettings).GetIsSwipeNavigationEnabled, (*ICoreWebViewSettings).PutIsSwipeNavigationEnabled},
+	{"ReputationCheckingRequired", 8, func(s *Settings) *bool { return &s.ReputationCheckingRequired },
+		(*ICoreWebViewSettings).GetIsReputationCheckingRequired, (*ICoreWebViewSettings).PutIsReputationCheckingRequired},
+	{"NonClientRegionSupportEnabled", 9, func(s *Settings) *bool { return &s.NonClientRegionSupportEnabled },
+		(*ICoreWebViewSettings).GetIsNonClientRegionSupportEnabled, (*ICoreWebViewSettings).PutIsNonClientRegionSupportEnabled},
+}
+
+const (
+	userAgentVersion             = 2
+	hiddenPdfToolbarItemsVersion = 7
+	nonClientRegionVersion       = 9
+)
+
+// SettingsVersion returns the newest ICoreWebView2SettingsN interface supported by the installed runtime.
+func (e *Chromium) SettingsVersion() (int, error) {
+	settings, err := e.GetSettings()
+	if err != nil {
+		return 0, err
+	}
+	defer settings.Release()
+	return settings.Version(), nil
+}
+
+// ReadSettings returns the current settings of the webview. Settings the installed runtime doesn't support keep
+// their default values.
+func (e *Chromium) ReadSettings() (Settings, error) {
+	settings, err := e.GetSettings()
+	if err != nil {
+		return Settings{}, err
+	}
+	defer settings.Release()
+	return readSettings(settings, settings.Version())
+}
+
+// PutSettings applies all settings or none of them. Settings which differ from DefaultSettings but aren't
+// supported by the installed runtime are reported as ErrNotSupported before anything is changed, the previous
+// settings are restored if applying one of them fails.
+func (e *Chromium) PutSettings(s Settings) error {
+	settings, err := e.GetSettings()
+	if err != nil {
+		return err
+	}
+	defer settings.Release()
+
+	version := settings.Version()
+	if err := unsupportedSettings(s, version); err != nil {
+		return err
+	}
+
+	previous, err := readSettings(settings, version)
+	if err != nil {
+		return fmt.Errorf("reading the current settings: %w", err)
+	}
+	if err := writeSettings(settings, version, s); err != nil {
+		if rerr := writeSettings(settings, version, previous); rerr != nil {
+			return errors.Join(err, fmt.Errorf("restoring the previous settings: %w", rerr))
+		}
+		return err
+	}
+	return nil
+}
+
+// EnableNonClientRegionSupport lets pages mark draggable regions with the app-region CSS property and reports
+// whether the installed runtime supports it.
+func (e *Chromium) EnableNonClientRegionSupport() (bool, error) {
+	settings, err := e.GetSettings()
+	if err != nil {
+		return false, err
+	}
+	defer settings.Release()
+
+	if settings.Version() < nonClientRegionVersion {
+		return false, nil
+	}
+	if err := settings.PutIsNonClientRegionSupportEnabled(true); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func unsupportedSettings(s Settings, version int) error {
+	var errs []error
+	unsupported := func(name string, needs int) {
+		errs = append(errs, fmt.Errorf("%s needs ICoreWebView2Settings%d, the runtime only supports %d: %w", name, needs, version, ErrNotSupported))
+	}
+
+	defaults := DefaultSettings()
+	for _, b := range boolSettings {
+		if b.version > version && *b.field(&s) != *b.field(&defaults) {
+			unsupported(b.name, b.version)
+		}
+	}
+	if userAgentVersion > version && s.UserAgent != "" {
+		unsupported("UserAgent", userAgentVersion)
+	}
+	if hiddenPdfToolbarItemsVersion > version && s.HiddenPdfToolbarItems != defaults.HiddenPdfToolbarItems {
+		unsupported("HiddenPdfToolbarItems", hiddenPdfToolbarItemsVersion)
+	}
+	return errors.Join(errs...)
+}
+
+func readSettings(settings *ICoreWebViewSettings, version int) (Settings, error) {
+	s := DefaultSettings()
+	for _, b := range boolSettings {
+		if b.version > version {
+			continue
+		}
+		value, err := b.get(settings)
+		if err != nil {
+			return Settings{}, fmt.Errorf("%s: %w", b.name, err)
+		}
+		*b.field(&s) = value
+	}
+
+	var err error
+	if version >= userAgentVersion {
+		if s.UserAgent, err = settings.GetUserAgent(); err != nil {
+			return Settings{}, fmt.Errorf("UserAgent: %w", err)
+		}
+	}
+	if version >= hiddenPdfToolbarItemsVersion {
+		if s.HiddenPdfToolbarItems, err = settings.GetHiddenPdfToolbarItems(); err != nil {
+			return Settings{}, fmt.Errorf("HiddenPdfToolbarItems: %w", err)
+		}
+	}
+	return s, nil
+}
+
+func writeSettings(settings *ICoreWebViewSettings, version int, s Settings) error {
+	for _, b := range boolSettings {
+		if b.version > version {
+			continue
+		}
+		if err := b.put(settings, *b.field(&s)); err != nil {
+			return fmt.Errorf("%s: %w", b.name, err)
+		}
+	}
+
+	if version >= userAgentVersion && s.UserAgent != "" {
+		if err := settings.PutUserAgent(s.UserAgent); err != nil {
+			return fmt.Errorf("UserAgent: %w", err)
+		}
+	}
+	if version >= hiddenPdfToolbarItemsVersion {
+		if err := settings.PutHiddenPdfToolbarItems(s.HiddenPdfToolbarItems); err != nil {
+			return fmt.Errorf("HiddenPdfToolbarItems: %w", err)
+		}
+	}
+	return nil
+}
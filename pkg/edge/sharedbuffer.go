@@ -0,0 +1,72 @@
+//go:build windows
+
+package edge
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// ErrSharedBufferClosed is returned when a SharedBuffer is used after it has been closed.
+var ErrSharedBufferClosed = errors.New("the shared buffer has been closed")
+
+// SharedBuffer is memory which is shared with scripts of the page without copying it. Scripts receive it as
+// ArrayBuffer by listening to the sharedbufferreceived event of window.chrome.webview.
+type SharedBuffer struct {
+	buffer *ICoreWebView2SharedBuffer
+	data   []byte
+}
+
+// CreateSharedBuffer allocates a SharedBuffer of size bytes. It must be closed after finishing using it.
+func (e *Chromium) CreateSharedBuffer(size uint64) (*SharedBuffer, error) {
+	environment12 := e.environment.GetICoreWebView2Environment12()
+	if environment12 == nil {
+		return nil, ErrNotSupported
+	}
+	defer environment12.Release()
+
+	buffer, err := environment12.CreateSharedBuffer(size)
+	if err != nil {
+		return nil, err
+	}
+	data, err := buffer.GetBuffer()
+	if err != nil {
+		buffer.Release()
+		return nil, err
+	}
+	return &SharedBuffer{buffer: buffer, data: unsafe.Slice((*byte)(data), size)}, nil
+}
+
+// Bytes returns the shared memory. The slice must not be used after the buffer has been closed.
+func (b *SharedBuffer) Bytes() []byte {
+	return b.data
+}
+
+// Close unmaps the memory from the host and from all scripts it has been posted to, their ArrayBuffers are detached
+// and become empty.
+func (b *SharedBuffer) Close() error {
+	if b.buffer == nil {
+		return nil
+	}
+
+	err := b.buffer.Close()
+	b.buffer.Release()
+	b.buffer = nil
+	b.data = nil
+	return err
+}
+
+// PostSharedBufferToScript posts the buffer to the top level document. additionalDataAsJSON is passed to the
+// script as additionalData of the event and may be empty.
+func (e *Chromium) PostSharedBufferToScript(buffer *SharedBuffer, access COREWEBVIEW2_SHARED_BUFFER_ACCESS, additionalDataAsJSON string) error {
+	if buffer.buffer == nil {
+		return ErrSharedBufferClosed
+	}
+
+	webview17 := e.webview.GetICoreWebView2_17()
+	if webview17 == nil {
+		return ErrNotSupported
+	}
+	defer webview17.Release()
+	return webview17.PostSharedBufferToScript(buffer.buffer, access, additionalDataAsJSON)
+}
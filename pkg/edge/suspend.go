@@ -0,0 +1,69 @@
+//go:build windows
+
+package edge
+
+// TrySuspend suspends the page to save memory and CPU while the WebView is hidden. Suspending fails if the
+// WebView is visible, so call Hide first. completed is called on the UI thread and reports whether the page has
+// been suspended, it might refuse e.g. while audio is playing.
+func (e *Chromium) TrySuspend(completed func(suspended bool, err error)) error {
+	webview3 := e.webview.GetICoreWebView2_3()
+	if webview3 == nil {
+		return ErrNotSupported
+	}
+	defer webview3.Release()
+
+	c := &trySuspendCompleted{fn: completed}
+	c.handler = newICoreWebView2TrySuspendCompletedHandler(c)
+	keepAlive(c)
+	if err := webview3.TrySuspend(c.handler); err != nil {
+		releaseKeepAlive(c)
+		return err
+	}
+	return nil
+}
+
+type trySuspendCompleted struct {
+	completionImpl
+	handler *ICoreWebView2TrySuspendCompletedHandler
+	fn      func(suspended bool, err error)
+}
+
+func (c *trySuspendCompleted) TrySuspendCompleted(errorCode uintptr, isSuccessful int32) uintptr {
+	releaseKeepAlive(c)
+
+	if c.fn != nil {
+		c.fn(isSuccessful != 0, errorFromHRESULT(errorCode))
+	}
+	return 0
+}
+
+// Resume resumes a page which has been suspended with TrySuspend. Showing the WebView also resumes it.
+func (e *Chromium) Resume() error {
+	webview3 := e.webview.GetICoreWebView2_3()
+	if webview3 == nil {
+		return ErrNotSupported
+	}
+	defer webview3.Release()
+	return webview3.Resume()
+}
+
+// IsSuspended reports whether the page is currently suspended.
+func (e *Chromium) IsSuspended() (bool, error) {
+	webview3 := e.webview.GetICoreWebView2_3()
+	if webview3 == nil {
+		return false, ErrNotSupported
+	}
+	defer webview3.Release()
+	return webview3.GetIsSuspended()
+}
+
+// PutMemoryUsageTargetLevel asks the browser processes to trim their memory usage. A low level is only a hint and
+// is meant for WebViews which are not visible to the user.
+func (e *Chromium) PutMemoryUsageTargetLevel(level COREWEBVIEW2_MEMORY_USAGE_TARGET_LEVEL) error {
+	webview19 := e.webview.GetICoreWebView2_19()
+	if webview19 == nil {
+		return ErrNotSupported
+	}
+	defer webview19.Release()
+	return webview19.PutMemoryUsageTargetLevel(level)
+}
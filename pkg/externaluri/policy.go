@@ -0,0 +1,60 @@
+// Package externaluri decides how URIs with schemes the WebView can't open itself are opened.
+package externaluri
+
+import (
+	"strings"
+
+	"github.com/b1naryth1ef/wv2/internal/origin"
+)
+
+type Action int
+
+const (
+	// Prompt lets the WebView ask the user whether the URI should be opened.
+	Prompt Action = iota
+	// Launch opens the URI with the application registered for its scheme without asking.
+	Launch
+	// Ask asks the user with a dialog of the window instead of the prompt of the WebView.
+	Ask
+	// Handle passes the URI to wv2.WindowOpts.ExternalURIHandler, e.g. for deep links into the application.
+	Handle
+	// Block ignores the URI.
+	Block
+)
+
+// Rule selects the action for URIs with schemes the WebView can't open itself, e.g. mailto: links.
+type Rule struct {
+	// Scheme is the scheme of the URI without the colon, e.g. "mailto". An empty Scheme or "*" matches all schemes.
+	Scheme string
+	// Origin is the origin of the page opening the URI, e.g. "https://example.com". "https://*.example.com" matches
+	// the subdomains of example.com. An empty Origin or "*" matches all pages.
+	Origin string
+	Action Action
+}
+
+// Policy decides how URIs with external schemes are opened. The first rule matching the URI wins, so specific rules
+// have to be put before general ones. An allowlist of schemes can be built from rules with the Launch action and
+// Block as Default.
+type Policy struct {
+	Rules []Rule
+	// Default is used if no rule matches.
+	Default Action
+}
+
+// Action returns the action for uri opened by a page of pageOrigin.
+func (p Policy) Action(uri, pageOrigin string) Action {
+	scheme := origin.Scheme(uri)
+	for _, rule := range p.Rules {
+		if rule.matches(scheme, pageOrigin) {
+			return rule.Action
+		}
+	}
+	return p.Default
+}
+
+func (r Rule) matches(scheme, pageOrigin string) bool {
+	if r.Scheme != "" && r.Scheme != "*" && !strings.EqualFold(strings.TrimSuffix(r.Scheme, ":"), scheme) {
+		return false
+	}
+	return origin.Match(r.Origin, pageOrigin)
+}
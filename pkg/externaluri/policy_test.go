@@ -0,0 +1,44 @@
+package externaluri
+
+import "testing"
+
+func TestPolicyAction(t *testing.T) {
+	policy := Policy{
+		Rules: []Rule{
+			{Scheme: "mailto", Origin: "https://*.example.com", Action: Launch},
+			{Scheme: "MAILTO:", Action: Ask},
+			{Scheme: "zoommtg", Origin: "https://meet.example.com/", Action: Handle},
+			{Scheme: "zoommtg", Action: Block},
+			{Scheme: "*", Origin: "https://trusted.example.org", Action: Launch},
+		},
+		Default: Block,
+	}
+
+	tests := []struct {
+		name   string
+		policy Policy
+		uri    string
+		origin string
+		want   Action
+	}{
+		{"first rule wins", policy, "mailto:user@example.com", "https://www.example.com", Launch},
+		{"scheme case and trailing colon", policy, "MailTo:user@example.com", "https://other.org", Ask},
+		{"wildcard origin doesn't match the bare domain", policy, "mailto:user@example.com", "https://example.com", Ask},
+		{"trailing slash", policy, "zoommtg://join", "https://meet.example.com", Handle},
+		{"later rule for the same scheme", policy, "zoommtg://join", "https://other.org", Block},
+		{"wildcard scheme", policy, "ms-settings:display", "https://trusted.example.org", Launch},
+		{"origin scheme mismatch", policy, "ms-settings:display", "http://trusted.example.org", Block},
+		{"scheme mismatch uses the default", policy, "tel:+123", "https://www.example.com", Block},
+		{"invalid scheme uses the default", policy, "1mailto:user@example.com", "https://www.example.com", Block},
+		{"empty rule matches everything", Policy{Rules: []Rule{{Action: Ask}}, Default: Block}, "tel:+123", "", Ask},
+		{"no rules", Policy{Default: Launch}, "mailto:user@example.com", "https://example.com", Launch},
+		{"zero policy prompts", Policy{}, "mailto:user@example.com", "https://example.com", Prompt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Action(tt.uri, tt.origin); got != tt.want {
+				t.Errorf("Action(%q, %q) = %d, want %d", tt.uri, tt.origin, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// Package permissions answers the permission requests of pages with rules per origin and remembers the decisions of
+// the user.
+package permissions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/b1naryth1ef/wv2/internal/origin"
+)
+
+// Kind is the kind of a permission requested by a page. The values are the same as the ones of
+// edge.CoreWebView2PermissionKind.
+type Kind int
+
+const (
+	Unknown Kind = iota
+	Microphone
+	Camera
+	Geolocation
+	Notifications
+	OtherSensors
+	ClipboardRead
+	MultipleAutomaticDownloads
+	FileReadWrite
+	Autoplay
+	LocalFonts
+	MidiSystemExclusiveMessages
+	WindowManagement
+)
+
+var kindNames = []string{
+	Unknown:                     "unknown",
+	Microphone:                  "microphone",
+	Camera:                      "camera",
+	Geolocation:                 "geolocation",
+	Notifications:               "notifications",
+	OtherSensors:                "other-sensors",
+	ClipboardRead:               "clipboard-read",
+	MultipleAutomaticDownloads:  "multiple-automatic-downloads",
+	FileReadWrite:               "file-read-write",
+	Autoplay:                    "autoplay",
+	LocalFonts:                  "local-fonts",
+	MidiSystemExclusiveMessages: "midi-system-exclusive-messages",
+	WindowManagement:            "window-management",
+}
+
+func (k Kind) String() string {
+	if k >= 0 && int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("permissions.Kind(%d)", int(k))
+}
+
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+func (k *Kind) UnmarshalText(text []byte) error {
+	for i, name := range kindNames {
+		if name == string(text) {
+			*k = Kind(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown permission kind %q", text)
+}
+
+// State is the answer to a permission request. The values are the same as the ones of
+// edge.CoreWebView2PermissionState.
+type State int
+
+const (
+	// Default lets the webview ask the user.
+	Default State = iota
+	Allow
+	Deny
+)
+
+var stateNames = []string{
+	Default: "default",
+	Allow:   "allow",
+	Deny:    "deny",
+}
+
+func (s State) String() string {
+	if s >= 0 && int(s) < len(stateNames) {
+		return stateNames[s]
+	}
+	return fmt.Sprintf("permissions.State(%d)", int(s))
+}
+
+func (s State) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *State) UnmarshalText(text []byte) error {
+	for i, name := range stateNames {
+		if name == string(text) {
+			*s = State(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown permission state %q", text)
+}
+
+// Rule answers the permission requests of the pages matching Origin. Origin is an origin like
+// "https://example.com", "https://*.example.com" matches the subdomains of example.com and an empty Origin or "*"
+// matches all pages. An empty Kinds matches all kinds.
+type Rule struct {
+	Origin string
+	Kinds  []Kind
+	State  State
+}
+
+// Rules are evaluated in order, the first rule matching a request wins.
+type Rules []Rule
+
+// State returns the state of the first rule matching a request of origin for kind, or Default if no rule matches.
+func (r Rules) State(origin string, kind Kind) State {
+	for _, rule := range r {
+		if rule.matches(origin, kind) {
+			return rule.State
+		}
+	}
+	return Default
+}
+
+func (r Rule) matches(pageOrigin string, kind Kind) bool {
+	if !origin.Match(r.Origin, pageOrigin) {
+		return false
+	}
+	if len(r.Kinds) == 0 {
+		return true
+	}
+	for _, k := range r.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Store remembers the decisions of the user per origin in a JSON file. It is safe for concurrent use.
+type Store struct {
+	path string
+
+	mu        sync.Mutex
+	decisions map[string]map[Kind]State
+}
+
+// Open loads the decisions stored at path. The file is created when the first decision is saved.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, decisions: make(map[string]map[Kind]State)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.decisions); err != nil {
+		return nil, fmt.Errorf("reading permission store %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Get returns the decision stored for kind at origin.
+func (s *Store) Get(origin string, kind Kind) (State, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.decisions[origin][kind]
+	return state, ok
+}
+
+// Set stores the decision for kind at origin and saves the store. Default removes the decision.
+func (s *Store) Set(origin string, kind Kind, state State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state == Default {
+		delete(s.decisions[origin], kind)
+		if len(s.decisions[origin]) == 0 {
+			delete(s.decisions, origin)
+		}
+	} else {
+		if s.decisions[origin] == nil {
+			s.decisions[origin] = make(map[Kind]State)
+		}
+		s.decisions[origin][kind] = state
+	}
+	return s.save()
+}
+
+// Reset removes all decisions for origin, or all decisions if origin is empty, and saves the store.
+func (s *Store) Reset(origin string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if origin == "" {
+		s.decisions = make(map[string]map[Kind]State)
+	} else {
+		delete(s.decisions, origin)
+	}
+	return s.save()
+}
+
+// Origins returns the decisions of all origins.
+func (s *Store) Origins() map[string]map[Kind]State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	origins := make(map[string]map[Kind]State, len(s.decisions))
+	for origin, decisions := range s.decisions {
+		origins[origin] = make(map[Kind]State, len(decisions))
+		for kind, state := range decisions {
+			origins[origin][kind] = state
+		}
+	}
+	return origins
+}
+
+// save writes the store to a temporary file first, so a crash doesn't leave a truncated store behind.
+func (s *Store) save() error {
+	data, err := json.MarshalIndent(s.decisions, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,197 @@
+package permissions
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestRulesState(t *testing.T) {
+	rules := Rules{
+		{Origin: "https://meet.example.com", Kinds: []Kind{Camera, Microphone}, State: Allow},
+		{Origin: "https://*.example.com", Kinds: []Kind{Camera}, State: Deny},
+		{Origin: "https://*.example.com", State: Allow},
+		{Origin: "https://example.org/", Kinds: []Kind{Notifications}, State: Deny},
+		{Kinds: []Kind{Geolocation}, State: Deny},
+	}
+
+	tests := []struct {
+		name   string
+		rules  Rules
+		origin string
+		kind   Kind
+		want   State
+	}{
+		{"specific rule before general ones", rules, "https://meet.example.com", Camera, Allow},
+		{"first matching kind", rules, "https://www.example.com", Camera, Deny},
+		{"rule without kinds matches all kinds", rules, "https://www.example.com", Microphone, Allow},
+		{"later rule matches other kinds of the same origin", rules, "https://meet.example.com", Autoplay, Allow},
+		{"wildcard doesn't match the bare domain", rules, "https://example.com", Camera, Default},
+		{"trailing slash", rules, "https://EXAMPLE.org", Notifications, Deny},
+		{"kind mismatch", rules, "https://example.org", Camera, Default},
+		{"rule without origin matches all origins", rules, "http://localhost:8080", Geolocation, Deny},
+		{"rule without origin comes last", rules, "https://www.example.com", Geolocation, Allow},
+		{"empty origin", rules, "", Geolocation, Deny},
+		{"no rules", nil, "https://example.com", Camera, Default},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.State(tt.origin, tt.kind); got != tt.want {
+				t.Errorf("State(%q, %v) = %v, want %v", tt.origin, tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextMarshalling(t *testing.T) {
+	for k := Unknown; k <= WindowManagement; k++ {
+		text, err := k.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Kind
+		if err := got.UnmarshalText(text); err != nil || got != k {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, got, err, k)
+		}
+	}
+	for s := Default; s <= Deny; s++ {
+		text, err := s.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got State
+		if err := got.UnmarshalText(text); err != nil || got != s {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, got, err, s)
+		}
+	}
+
+	var k Kind
+	if err := k.UnmarshalText([]byte("teleportation")); err == nil {
+		t.Error("UnmarshalText succeeded for an unknown kind")
+	}
+	var s State
+	if err := s.UnmarshalText([]byte("maybe")); err == nil {
+		t.Error("UnmarshalText succeeded for an unknown state")
+	}
+}
+
+func TestStore(t *testing.T) {
+	const (
+		meet  = "https://meet.example.com"
+		other = "https://example.org"
+	)
+	path := filepath.Join(t.TempDir(), "app", "permissions.json")
+
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("opening a missing store failed: %v", err)
+	}
+	if _, ok := s.Get(meet, Camera); ok {
+		t.Fatal("empty store has a decision")
+	}
+	for _, d := range []struct {
+		origin string
+		kind   Kind
+		state  State
+	}{
+		{meet, Camera, Allow},
+		{meet, Microphone, Allow},
+		{meet, Notifications, Deny},
+		{other, Geolocation, Deny},
+	} {
+		if err := s.Set(d.origin, d.kind, d.state); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw map[string]map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]map[string]string{
+		meet:  {"camera": "allow", "microphone": "allow", "notifications": "deny"},
+		other: {"geolocation": "deny"},
+	}
+	if !reflect.DeepEqual(raw, want) {
+		t.Fatalf("stored %s, want %v", data, want)
+	}
+
+	s, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	origins := map[string]map[Kind]State{
+		meet:  {Camera: Allow, Microphone: Allow, Notifications: Deny},
+		other: {Geolocation: Deny},
+	}
+	if got := s.Origins(); !reflect.DeepEqual(got, origins) {
+		t.Fatalf("Origins() = %v, want %v", got, origins)
+	}
+
+	if err := s.Set(meet, Notifications, Default); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set(other, Geolocation, Default); err != nil {
+		t.Fatal(err)
+	}
+	s, _ = Open(path)
+	if _, ok := s.Get(meet, Notifications); ok {
+		t.Fatal("Set(Default) kept the decision")
+	}
+	if state, ok := s.Get(meet, Camera); !ok || state != Allow {
+		t.Fatalf("Get(camera) = %v, %t, want allow", state, ok)
+	}
+	if _, ok := s.Origins()[other]; ok {
+		t.Fatal("Set(Default) kept an origin without decisions")
+	}
+
+	if err := s.Set(other, Autoplay, Deny); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Reset(meet); err != nil {
+		t.Fatal(err)
+	}
+	s, _ = Open(path)
+	if _, ok := s.Get(meet, Camera); ok {
+		t.Fatal("Reset(origin) kept a decision")
+	}
+	if state, ok := s.Get(other, Autoplay); !ok || state != Deny {
+		t.Fatalf("Get(autoplay) = %v, %t, want deny", state, ok)
+	}
+
+	if err := s.Reset(""); err != nil {
+		t.Fatal(err)
+	}
+	s, _ = Open(path)
+	if got := s.Origins(); len(got) != 0 {
+		t.Fatalf("Reset of all origins kept %v", got)
+	}
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("temporary file left behind: %v", err)
+	}
+}
+
+func TestOpenInvalidStore(t *testing.T) {
+	tests := map[string]string{
+		"invalid JSON":  "{",
+		"unknown kind":  `{"https://example.com": {"teleportation": "allow"}}`,
+		"unknown state": `{"https://example.com": {"camera": "maybe"}}`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "permissions.json")
+			if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Open(path); err == nil {
+				t.Fatal("opening an invalid store succeeded")
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Package pinning verifies certificate chains against the pinned public keys of hosts.
+package pinning
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrHostNotPinned is returned by Pins.Verify if there are no pins for the host.
+	ErrHostNotPinned = errors.New("no certificate pins for host")
+	// ErrCertificateNotPinned is returned by Pins.Verify if no certificate of the chain matches a pin.
+	ErrCertificateNotPinned = errors.New("certificate chain doesn't match the pins of the host")
+)
+
+// Pins maps host names to the base64 encoded SHA-256 hashes of the SubjectPublicKeyInfo of the
+// certificates that are trusted for them, see SPKIHash. A host of the form "*.example.com" matches all subdomains of
+// example.com, exact host names take precedence.
+type Pins map[string][]string
+
+// SPKIHash returns the base64 encoded SHA-256 hash of the SubjectPublicKeyInfo of certificate, which is the same
+// value as the pin-sha256 of HPKP and can be created with:
+//
+//	openssl x509 -in cert.pem -pubkey -noout | openssl pkey -pubin -outform der | openssl dgst -sha256 -binary | base64
+func SPKIHash(certificate *x509.Certificate) string {
+	hash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// ForHost returns the pins for host or nil if it isn't pinned.
+func (p Pins) ForHost(host string) []string {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if pins, ok := p[host]; ok {
+		return pins
+	}
+
+	for domain := host; ; {
+		i := strings.IndexByte(domain, '.')
+		if i < 0 {
+			return nil
+		}
+		domain = domain[i+1:]
+		if pins, ok := p["*."+domain]; ok {
+			return pins
+		}
+	}
+}
+
+// Verify checks chain, which starts with the certificate of the server, against the pins of host. The chain is
+// accepted if the server certificate is valid for host at now and it is either pinned itself or has been issued,
+// directly or through the intermediates of the chain, by a pinned certificate. The system roots are not consulted,
+// so this also works for servers with certificates of private CAs.
+func (p Pins) Verify(host string, chain []*x509.Certificate, now time.Time) error {
+	pins := p.ForHost(host)
+	if len(pins) == 0 {
+		return ErrHostNotPinned
+	}
+	if len(chain) == 0 {
+		return ErrCertificateNotPinned
+	}
+
+	leaf := chain[0]
+	if err := leaf.VerifyHostname(host); err != nil {
+		return err
+	}
+	if now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
+		return x509.CertificateInvalidError{Cert: leaf, Reason: x509.Expired}
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range chain[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	for _, certificate := range chain {
+		if !containsPin(pins, SPKIHash(certificate)) {
+			continue
+		}
+		if certificate == leaf {
+			return nil
+		}
+
+		roots := x509.NewCertPool()
+		roots.AddCert(certificate)
+		_, err := leaf.Verify(x509.VerifyOptions{
+			DNSName:       host,
+			Roots:         roots,
+			Intermediates: intermediates,
+			CurrentTime:   now,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		if err == nil {
+			return nil
+		}
+	}
+	return ErrCertificateNotPinned
+}
+
+func containsPin(pins []string, pin string) bool {
+	for _, p := range pins {
+		if p == pin {
+			return true
+		}
+	}
+	return false
+}
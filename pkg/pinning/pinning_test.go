@@ -0,0 +1,230 @@
+package pinning
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCertificate(t *testing.T, template *x509.Certificate, issuer *testCertificate) *testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent, signer := template, crypto.Signer(key)
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCertificate{cert: cert, key: key}
+}
+
+func caTemplate(serial int64, name string) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             testNow.Add(-24 * time.Hour),
+		NotAfter:              testNow.Add(365 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+}
+
+// newTestChain returns the chain of a server for www.example.com, which has been issued by an intermediate of a
+// private root.
+func newTestChain(t *testing.T) (leaf, intermediate, root *testCertificate) {
+	root = newTestCertificate(t, caTemplate(1, "Test Root"), nil)
+	intermediate = newTestCertificate(t, caTemplate(2, "Test Intermediate"), root)
+	leaf = newTestCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		DNSNames:     []string{"www.example.com"},
+		NotBefore:    testNow.Add(-time.Hour),
+		NotAfter:     testNow.Add(30 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, intermediate)
+	return leaf, intermediate, root
+}
+
+func TestPinsVerify(t *testing.T) {
+	leaf, intermediate, root := newTestChain(t)
+	other := newTestCertificate(t, caTemplate(4, "Other Root"), nil)
+	chain := []*x509.Certificate{leaf.cert, intermediate.cert, root.cert}
+
+	tests := []struct {
+		name  string
+		pins  Pins
+		host  string
+		chain []*x509.Certificate
+		now   time.Time
+		check func(error) bool
+	}{
+		{
+			name: "leaf pin",
+			pins: Pins{"www.example.com": {SPKIHash(leaf.cert)}},
+		},
+		{
+			name: "intermediate pin",
+			pins: Pins{"www.example.com": {SPKIHash(intermediate.cert)}},
+		},
+		{
+			name: "root pin",
+			pins: Pins{"www.example.com": {SPKIHash(root.cert)}},
+		},
+		{
+			name:  "root pin without the root in the chain",
+			pins:  Pins{"www.example.com": {SPKIHash(root.cert)}},
+			chain: []*x509.Certificate{leaf.cert, intermediate.cert},
+			check: isErr(ErrCertificateNotPinned),
+		},
+		{
+			name:  "intermediate pin without the intermediate in the chain",
+			pins:  Pins{"www.example.com": {SPKIHash(intermediate.cert)}},
+			chain: []*x509.Certificate{leaf.cert, root.cert},
+			check: isErr(ErrCertificateNotPinned),
+		},
+		{
+			name: "wildcard host",
+			pins: Pins{"*.example.com": {SPKIHash(root.cert)}},
+		},
+		{
+			name: "host with upper case and trailing dot",
+			pins: Pins{"*.example.com": {SPKIHash(root.cert)}},
+			host: "WWW.Example.com.",
+		},
+		{
+			name:  "exact host takes precedence over wildcard",
+			pins:  Pins{"www.example.com": {SPKIHash(other.cert)}, "*.example.com": {SPKIHash(root.cert)}},
+			check: isErr(ErrCertificateNotPinned),
+		},
+		{
+			name:  "no pin for the host",
+			pins:  Pins{"example.org": {SPKIHash(root.cert)}},
+			check: isErr(ErrHostNotPinned),
+		},
+		{
+			name:  "pin of another certificate",
+			pins:  Pins{"www.example.com": {SPKIHash(other.cert)}},
+			check: isErr(ErrCertificateNotPinned),
+		},
+		{
+			name:  "empty chain",
+			pins:  Pins{"www.example.com": {SPKIHash(leaf.cert)}},
+			chain: []*x509.Certificate{},
+			check: isErr(ErrCertificateNotPinned),
+		},
+		{
+			name:  "expired chain",
+			pins:  Pins{"www.example.com": {SPKIHash(leaf.cert)}},
+			now:   testNow.Add(60 * 24 * time.Hour),
+			check: isInvalid(x509.Expired),
+		},
+		{
+			name:  "expired chain with root pin",
+			pins:  Pins{"www.example.com": {SPKIHash(root.cert)}},
+			now:   testNow.Add(60 * 24 * time.Hour),
+			check: isInvalid(x509.Expired),
+		},
+		{
+			name: "hostname mismatch",
+			pins: Pins{"*.example.com": {SPKIHash(leaf.cert)}},
+			host: "api.example.com",
+			check: func(err error) bool {
+				var hostnameErr x509.HostnameError
+				return errors.As(err, &hostnameErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, c, now := tt.host, tt.chain, tt.now
+			if host == "" {
+				host = "www.example.com"
+			}
+			if c == nil {
+				c = chain
+			}
+			if now.IsZero() {
+				now = testNow
+			}
+
+			err := tt.pins.Verify(host, c, now)
+			if tt.check == nil {
+				if err != nil {
+					t.Fatalf("Verify() = %v, want nil", err)
+				}
+				return
+			}
+			if !tt.check(err) {
+				t.Fatalf("Verify() = %v", err)
+			}
+		})
+	}
+}
+
+func isErr(want error) func(error) bool {
+	return func(err error) bool {
+		return errors.Is(err, want)
+	}
+}
+
+func isInvalid(reason x509.InvalidReason) func(error) bool {
+	return func(err error) bool {
+		var invalid x509.CertificateInvalidError
+		return errors.As(err, &invalid) && invalid.Reason == reason
+	}
+}
+
+func TestPinsForHost(t *testing.T) {
+	pins := Pins{
+		"example.com":       {"exact"},
+		"*.example.com":     {"wildcard"},
+		"*.api.example.com": {"api"},
+	}
+
+	tests := []struct {
+		host string
+		want string
+	}{
+		{"example.com", "exact"},
+		{"www.example.com", "wildcard"},
+		{"a.b.example.com", "wildcard"},
+		{"v1.api.example.com", "api"},
+		{"api.example.com", "wildcard"},
+		{"example.org", ""},
+		{"com", ""},
+	}
+	for _, tt := range tests {
+		got := pins.ForHost(tt.host)
+		if (len(got) == 0 && tt.want != "") || (len(got) > 0 && got[0] != tt.want) {
+			t.Errorf("ForHost(%q) = %v, want %q", tt.host, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Package shortcut parses and formats keyboard shortcuts like Ctrl+Shift+K and keeps a registry of their handlers.
+package shortcut
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Modifiers uses the same bits as winc.Modifiers, ModWin is only known to shortcuts.
+type Modifiers uint8
+
+const (
+	ModShift Modifiers = 1 << iota
+	ModCtrl
+	ModAlt
+	ModWin
+)
+
+// Shortcut is a key combined with modifiers, e.g. Ctrl+Shift+K. Key is a Windows virtual key code.
+type Shortcut struct {
+	Modifiers Modifiers
+	Key       uint16
+}
+
+// modifierNames lists the modifiers in the order they are formatted.
+var modifierNames = []struct {
+	mod  Modifiers
+	name string
+}{
+	{ModCtrl, "Ctrl"},
+	{ModAlt, "Alt"},
+	{ModShift, "Shift"},
+	{ModWin, "Win"},
+}
+
+var modifierAliases = map[string]Modifiers{
+	"ctrl":    ModCtrl,
+	"control": ModCtrl,
+	"alt":     ModAlt,
+	"menu":    ModAlt,
+	"shift":   ModShift,
+	"win":     ModWin,
+	"super":   ModWin,
+	"meta":    ModWin,
+}
+
+// keyNames maps virtual key codes to the names used by String, letters and digits are added by init.
+var keyNames = map[uint16]string{
+	0x08: "Backspace", 0x09: "Tab", 0x0D: "Enter", 0x13: "Pause", 0x1B: "Esc", 0x20: "Space",
+	0x21: "PageUp", 0x22: "PageDown", 0x23: "End", 0x24: "Home",
+	0x25: "Left", 0x26: "Up", 0x27: "Right", 0x28: "Down",
+	0x2C: "PrintScreen", 0x2D: "Insert", 0x2E: "Delete", 0x5D: "Apps",
+	0x6A: "NumMultiply", 0x6B: "NumAdd", 0x6D: "NumSubtract", 0x6E: "NumDecimal", 0x6F: "NumDivide",
+	0xBA: ";", 0xBB: "Plus", 0xBC: ",", 0xBD: "-", 0xBE: ".", 0xBF: "/", 0xC0: "`",
+	0xDB: "[", 0xDC: "\\", 0xDD: "]", 0xDE: "'",
+	0xAD: "VolumeMute", 0xAE: "VolumeDown", 0xAF: "VolumeUp",
+	0xB0: "MediaNextTrack", 0xB1: "MediaPrevTrack", 0xB2: "MediaStop", 0xB3: "MediaPlayPause",
+}
+
+// keyAliases are accepted by Parse in addition to the names of keyNames.
+var keyAliases = map[string]uint16{
+	"return": 0x0D, "escape": 0x1B, "del": 0x2E, "ins": 0x2D, "pgup": 0x21, "pgdn": 0x22,
+	"pagedown": 0x22, "pageup": 0x21, "+": 0xBB, "=": 0xBB, "minus": 0xBD, "comma": 0xBC, "period": 0xBE,
+	"arrowleft": 0x25, "arrowup": 0x26, "arrowright": 0x27, "arrowdown": 0x28,
+}
+
+var keyCodes = map[string]uint16{}
+
+func init() {
+	for k := uint16('0'); k <= '9'; k++ {
+		keyNames[k] = string(rune(k))
+		keyNames[0x60+k-'0'] = "Num" + string(rune(k))
+	}
+	for k := uint16('A'); k <= 'Z'; k++ {
+		keyNames[k] = string(rune(k))
+	}
+	for n := uint16(1); n <= 24; n++ {
+		keyNames[0x70+n-1] = fmt.Sprintf("F%d", n)
+	}
+	for code, name := range keyNames {
+		keyCodes[strings.ToLower(name)] = code
+	}
+	for alias, code := range keyAliases {
+		keyCodes[alias] = code
+	}
+}
+
+// Parse parses shortcuts like "Ctrl+Shift+K", "Alt+F4" or "Ctrl++". Names are case insensitive and the
+// modifiers may be in any order.
+func Parse(s string) (Shortcut, error) {
+	var parts []string
+	if rest, found := strings.CutSuffix(s, "++"); found {
+		// The plus key itself
+		parts = append(strings.Split(rest, "+"), "+")
+	} else {
+		parts = strings.Split(s, "+")
+	}
+
+	var shortcut Shortcut
+	for i, part := range parts {
+		name := strings.ToLower(strings.TrimSpace(part))
+		if name == "" {
+			return Shortcut{}, fmt.Errorf("invalid shortcut %q: empty key name", s)
+		}
+		if i < len(parts)-1 {
+			mod, ok := modifierAliases[name]
+			if !ok {
+				return Shortcut{}, fmt.Errorf("invalid shortcut %q: unknown modifier %q", s, part)
+			}
+			if shortcut.Modifiers&mod != 0 {
+				return Shortcut{}, fmt.Errorf("invalid shortcut %q: duplicate modifier %q", s, part)
+			}
+			shortcut.Modifiers |= mod
+			continue
+		}
+
+		if _, ok := modifierAliases[name]; ok {
+			return Shortcut{}, fmt.Errorf("invalid shortcut %q: missing key after the modifiers", s)
+		}
+		key, ok := keyCodes[name]
+		if !ok {
+			return Shortcut{}, fmt.Errorf("invalid shortcut %q: unknown key %q", s, part)
+		}
+		shortcut.Key = key
+	}
+	return shortcut, nil
+}
+
+// MustParse is like Parse but panics if s is invalid.
+func MustParse(s string) Shortcut {
+	shortcut, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return shortcut
+}
+
+// String formats the shortcut in the form accepted by Parse, e.g. "Ctrl+Shift+K".
+func (s Shortcut) String() string {
+	var b strings.Builder
+	for _, m := range modifierNames {
+		if s.Modifiers&m.mod != 0 {
+			b.WriteString(m.name)
+			b.WriteByte('+')
+		}
+	}
+	if name, ok := keyNames[s.Key]; ok {
+		b.WriteString(name)
+	} else {
+		fmt.Fprintf(&b, "0x%02X", s.Key)
+	}
+	return b.String()
+}
+
+type Scope int
+
+const (
+	// ScopeWindow triggers the shortcut whenever the window has the keyboard focus, also while the
+	// WebView has it.
+	ScopeWindow Scope = iota
+	// ScopeWebView only triggers the shortcut while the WebView has the keyboard focus.
+	ScopeWebView
+)
+
+func (s Scope) String() string {
+	switch s {
+	case ScopeWindow:
+		return "window"
+	case ScopeWebView:
+		return "webview"
+	}
+	return "unknown"
+}
+
+// ConflictError is returned by Registry.Register if the shortcut has already been registered for an
+// overlapping scope.
+type ConflictError struct {
+	Shortcut Shortcut
+	Scope    Scope
+	Existing Scope
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("shortcut %s in scope %s conflicts with the one registered in scope %s", e.Shortcut, e.Scope, e.Existing)
+}
+
+type binding struct {
+	shortcut Shortcut
+	scope    Scope
+	handler  func()
+}
+
+// Registry holds the keyboard shortcuts shared by the WebView and the native controls of a window. Handlers
+// are called on the UI thread and the key is not passed on to the page or control.
+type Registry struct {
+	mu       sync.Mutex
+	bindings []binding
+}
+
+// Register parses shortcut and calls handler when it is pressed within scope. A *ConflictError is returned if
+// the shortcut is already registered for an overlapping scope, the window scope overlaps with all others.
+func (s *Registry) Register(shortcut string, scope Scope, handler func()) error {
+	parsed, err := Parse(shortcut)
+	if err != nil {
+		return err
+	}
+	return s.RegisterShortcut(parsed, scope, handler)
+}
+
+// RegisterShortcut is like Register for an already parsed shortcut.
+func (s *Registry) RegisterShortcut(shortcut Shortcut, scope Scope, handler func()) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, b := range s.bindings {
+		if b.shortcut == shortcut && scopesOverlap(b.scope, scope) {
+			return &ConflictError{Shortcut: shortcut, Scope: scope, Existing: b.scope}
+		}
+	}
+	s.bindings = append(s.bindings, binding{shortcut: shortcut, scope: scope, handler: handler})
+	return nil
+}
+
+// Unregister removes the shortcut from scope and reports whether it had been registered.
+func (s *Registry) Unregister(shortcut Shortcut, scope Scope) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, b := range s.bindings {
+		if b.shortcut == shortcut && b.scope == scope {
+			s.bindings = append(s.bindings[:i], s.bindings[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Registered returns the registered shortcuts of scope.
+func (s *Registry) Registered(scope Scope) []Shortcut {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var shortcuts []Shortcut
+	for _, b := range s.bindings {
+		if b.scope == scope {
+			shortcuts = append(shortcuts, b.shortcut)
+		}
+	}
+	return shortcuts
+}
+
+// Match returns the handler of shortcut or nil if none is registered for the current focus.
+func (s *Registry) Match(shortcut Shortcut, webViewFocused bool) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, b := range s.bindings {
+		if b.shortcut == shortcut && (b.scope == ScopeWindow || webViewFocused) {
+			return b.handler
+		}
+	}
+	return nil
+}
+
+func scopesOverlap(a, b Scope) bool {
+	return a == b || a == ScopeWindow || b == ScopeWindow
+}
@@ -0,0 +1,189 @@
+package shortcut
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Shortcut
+		str  string
+	}{
+		{"Ctrl+Shift+K", Shortcut{ModCtrl | ModShift, 'K'}, "Ctrl+Shift+K"},
+		{"shift+ctrl+k", Shortcut{ModCtrl | ModShift, 'K'}, "Ctrl+Shift+K"},
+		{"Ctrl++", Shortcut{ModCtrl, 0xBB}, "Ctrl+Plus"},
+		{"Ctrl+Plus", Shortcut{ModCtrl, 0xBB}, "Ctrl+Plus"},
+		{"Alt+F4", Shortcut{ModAlt, 0x73}, "Alt+F4"},
+		{"F24", Shortcut{0, 0x87}, "F24"},
+		{"Control + Alt + Del", Shortcut{ModCtrl | ModAlt, 0x2E}, "Ctrl+Alt+Delete"},
+		{"Super+Num5", Shortcut{ModWin, 0x65}, "Win+Num5"},
+		{"Ctrl+-", Shortcut{ModCtrl, 0xBD}, "Ctrl+-"},
+		{"Ctrl+Shift+ArrowUp", Shortcut{ModCtrl | ModShift, 0x26}, "Ctrl+Shift+Up"},
+		{"Esc", Shortcut{0, 0x1B}, "Esc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Parse(%q) = %#v, want %#v", tt.in, got, tt.want)
+			}
+			if s := got.String(); s != tt.str {
+				t.Fatalf("String() = %q, want %q", s, tt.str)
+			}
+			again, err := Parse(got.String())
+			if err != nil || again != got {
+				t.Fatalf("Parse(%q) = %#v, %v, want %#v", got.String(), again, err, got)
+			}
+		})
+	}
+}
+
+func TestStringUnknownKey(t *testing.T) {
+	if s := (Shortcut{ModCtrl, 0xFF}).String(); s != "Ctrl+0xFF" {
+		t.Fatalf("String() = %q, want Ctrl+0xFF", s)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", "empty key name"},
+		{"Ctrl+", "empty key name"},
+		{"Ctrl++Shift", "empty key name"},
+		{"Hyper+K", `unknown modifier "Hyper"`},
+		{"K+Ctrl", `unknown modifier "K"`},
+		{"Ctrl+Control+K", `duplicate modifier "Control"`},
+		{"Win+Super+K", `duplicate modifier "Super"`},
+		{"Ctrl+Shift", "missing key"},
+		{"Alt", "missing key"},
+		{"Ctrl+Foo", `unknown key "Foo"`},
+		{"Ctrl+F25", `unknown key "F25"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := Parse(tt.in)
+			if err == nil {
+				t.Fatalf("Parse(%q) succeeded", tt.in)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Parse(%q) = %v, want an error containing %q", tt.in, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryConflicts(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		scope    Scope
+		add      string
+		addScope Scope
+		conflict bool
+	}{
+		{"window and window", "Ctrl+K", ScopeWindow, "ctrl+k", ScopeWindow, true},
+		{"window and webview", "Ctrl+K", ScopeWindow, "Ctrl+K", ScopeWebView, true},
+		{"webview and window", "Ctrl+K", ScopeWebView, "Ctrl+K", ScopeWindow, true},
+		{"webview and webview", "Ctrl+K", ScopeWebView, "Ctrl+K", ScopeWebView, true},
+		{"other key", "Ctrl+K", ScopeWindow, "Ctrl+L", ScopeWindow, false},
+		{"other modifiers", "Ctrl+K", ScopeWebView, "Ctrl+Shift+K", ScopeWindow, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r Registry
+			if err := r.Register(tt.existing, tt.scope, func() {}); err != nil {
+				t.Fatalf("Register(%q) failed: %v", tt.existing, err)
+			}
+
+			err := r.Register(tt.add, tt.addScope, func() {})
+			if !tt.conflict {
+				if err != nil {
+					t.Fatalf("Register(%q) failed: %v", tt.add, err)
+				}
+				return
+			}
+
+			var conflict *ConflictError
+			if !errors.As(err, &conflict) {
+				t.Fatalf("Register(%q) = %v, want a *ConflictError", tt.add, err)
+			}
+			want := ConflictError{Shortcut: MustParse(tt.add), Scope: tt.addScope, Existing: tt.scope}
+			if *conflict != want {
+				t.Fatalf("conflict = %+v, want %+v", *conflict, want)
+			}
+		})
+	}
+}
+
+func TestRegistryInvalidShortcut(t *testing.T) {
+	var r Registry
+	if err := r.Register("Ctrl+Foo", ScopeWindow, func() {}); err == nil {
+		t.Fatal("Register succeeded for an invalid shortcut")
+	}
+	if len(r.Registered(ScopeWindow)) != 0 {
+		t.Fatal("invalid shortcut has been registered")
+	}
+}
+
+func TestRegistryUnregister(t *testing.T) {
+	var r Registry
+	k := MustParse("Ctrl+K")
+	if err := r.RegisterShortcut(k, ScopeWebView, func() {}); err != nil {
+		t.Fatal(err)
+	}
+	if r.Unregister(k, ScopeWindow) {
+		t.Fatal("Unregister removed the shortcut from another scope")
+	}
+	if !r.Unregister(k, ScopeWebView) {
+		t.Fatal("Unregister didn't find the shortcut")
+	}
+	if err := r.RegisterShortcut(k, ScopeWindow, func() {}); err != nil {
+		t.Fatalf("RegisterShortcut after Unregister failed: %v", err)
+	}
+	if got := r.Registered(ScopeWindow); len(got) != 1 || got[0] != k {
+		t.Fatalf("Registered() = %v, want [%v]", got, k)
+	}
+}
+
+func TestRegistryMatch(t *testing.T) {
+	var r Registry
+	var called string
+	register := func(s string, scope Scope) {
+		if err := r.Register(s, scope, func() { called = s }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	register("Ctrl+K", ScopeWindow)
+	register("Ctrl+L", ScopeWebView)
+
+	tests := []struct {
+		shortcut string
+		focused  bool
+		want     string
+	}{
+		{"Ctrl+K", false, "Ctrl+K"},
+		{"Ctrl+K", true, "Ctrl+K"},
+		{"Ctrl+L", false, ""},
+		{"Ctrl+L", true, "Ctrl+L"},
+		{"Ctrl+Shift+K", true, ""},
+		{"K", false, ""},
+	}
+	for _, tt := range tests {
+		called = ""
+		handler := r.Match(MustParse(tt.shortcut), tt.focused)
+		if handler != nil {
+			handler()
+		}
+		if called != tt.want {
+			t.Errorf("Match(%q, %t) called %q, want %q", tt.shortcut, tt.focused, called, tt.want)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Package windowstate saves the placement of windows and moves it onto the connected monitors when it is restored.
+package windowstate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Bounds is a rectangle in physical screen coordinates.
+type Bounds struct {
+	X, Y          int
+	Width, Height int
+}
+
+func (b Bounds) empty() bool {
+	return b.Width <= 0 || b.Height <= 0
+}
+
+// intersection returns the area b shares with o.
+func (b Bounds) intersection(o Bounds) int {
+	w := minInt(b.X+b.Width, o.X+o.Width) - maxInt(b.X, o.X)
+	h := minInt(b.Y+b.Height, o.Y+o.Height) - maxInt(b.Y, o.Y)
+	if w <= 0 || h <= 0 {
+		return 0
+	}
+	return w * h
+}
+
+// State is the placement of a window saved in a Store.
+type State struct {
+	// Bounds of the window while it is neither maximised nor fullscreen.
+	Bounds     Bounds `json:"bounds"`
+	Maximised  bool   `json:"maximised,omitempty"`
+	Fullscreen bool   `json:"fullscreen,omitempty"`
+	// Monitor is the device name of the monitor the window was on, e.g. \\.\DISPLAY1, and MonitorWork its work
+	// area at that time. They are used to find the monitor again after the displays have been rearranged.
+	Monitor     string `json:"monitor,omitempty"`
+	MonitorWork Bounds `json:"monitorWork"`
+}
+
+// Monitor is a monitor connected when the state is restored.
+type Monitor struct {
+	// Name is the device name of the monitor, e.g. \\.\DISPLAY1, and Work its work area.
+	Name string
+	Work Bounds
+}
+
+// Clamp moves the saved state onto the connected monitors, the first of them must be the primary one. The window
+// stays on its monitor if that is still connected, it keeps its absolute position if it is still mostly visible and
+// it moves to the primary monitor otherwise. It is shrunk to the work area of the monitor if it has become too
+// small.
+func Clamp(s State, monitors []Monitor) (State, bool) {
+	if s.Bounds.empty() || len(monitors) == 0 {
+		return State{}, false
+	}
+
+	// The position relative to the monitor, which stays the same if the monitor moved.
+	relX, relY := s.Bounds.X-s.MonitorWork.X, s.Bounds.Y-s.MonitorWork.Y
+	target, relative := -1, true
+	for i, m := range monitors {
+		if s.Monitor != "" && m.Name == s.Monitor {
+			target = i
+			break
+		}
+	}
+	if target < 0 {
+		best := 0
+		for i, m := range monitors {
+			if area := s.Bounds.intersection(m.Work); area > best {
+				target, best = i, area
+			}
+		}
+		relative = false
+		if target < 0 || best*2 < s.Bounds.Width*s.Bounds.Height {
+			target, relative = 0, true
+		}
+	}
+
+	m := monitors[target]
+	if s.MonitorWork.empty() && relative {
+		// Without the work area the position can't be moved along.
+		relX, relY = s.Bounds.X-m.Work.X, s.Bounds.Y-m.Work.Y
+	}
+	if relative {
+		s.Bounds.X, s.Bounds.Y = m.Work.X+relX, m.Work.Y+relY
+	}
+
+	s.Bounds.Width = minInt(s.Bounds.Width, m.Work.Width)
+	s.Bounds.Height = minInt(s.Bounds.Height, m.Work.Height)
+	s.Bounds.X = minInt(maxInt(s.Bounds.X, m.Work.X), m.Work.X+m.Work.Width-s.Bounds.Width)
+	s.Bounds.Y = minInt(maxInt(s.Bounds.Y, m.Work.Y), m.Work.Y+m.Work.Height-s.Bounds.Height)
+	s.Monitor = m.Name
+	s.MonitorWork = m.Work
+	return s, true
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Store saves the placement of windows by key in a JSON file. It is safe for concurrent use.
+type Store struct {
+	path string
+
+	mu     sync.Mutex
+	states map[string]State
+}
+
+// Open loads the states stored at path. The file is created when the first state is saved.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, states: make(map[string]State)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.states); err != nil {
+		return nil, fmt.Errorf("reading window state store %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Get returns the state stored for key.
+func (s *Store) Get(key string) (State, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[key]
+	return state, ok
+}
+
+// Set stores the state for key and saves the store.
+func (s *Store) Set(key string, state State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[key] = state
+	return s.save()
+}
+
+// Reset removes the state of key, or all states if key is empty, and saves the store.
+func (s *Store) Reset(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key == "" {
+		s.states = make(map[string]State)
+	} else {
+		delete(s.states, key)
+	}
+	return s.save()
+}
+
+// save writes the store to a temporary file first, so a crash doesn't leave a truncated store behind.
+func (s *Store) save() error {
+	data, err := json.MarshalIndent(s.states, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
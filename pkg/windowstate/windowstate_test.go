@@ -0,0 +1,190 @@
+package windowstate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	primary = Monitor{Name: `\\.\DISPLAY1`, Work: Bounds{X: 0, Y: 0, Width: 1920, Height: 1040}}
+	right   = Monitor{Name: `\\.\DISPLAY2`, Work: Bounds{X: 1920, Y: 0, Width: 1920, Height: 1040}}
+	left    = Monitor{Name: `\\.\DISPLAY2`, Work: Bounds{X: -1280, Y: 200, Width: 1280, Height: 984}}
+	third   = Monitor{Name: `\\.\DISPLAY3`, Work: Bounds{X: 1920, Y: 0, Width: 2560, Height: 1400}}
+)
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    State
+		monitors []Monitor
+		want     State
+		ok       bool
+	}{
+		{
+			name:     "unchanged",
+			state:    State{Bounds: Bounds{2000, 100, 800, 600}, Maximised: true, Monitor: right.Name, MonitorWork: right.Work},
+			monitors: []Monitor{primary, right},
+			want:     State{Bounds: Bounds{2000, 100, 800, 600}, Maximised: true, Monitor: right.Name, MonitorWork: right.Work},
+			ok:       true,
+		},
+		{
+			name:     "same monitor moved",
+			state:    State{Bounds: Bounds{2000, 100, 800, 600}, Monitor: right.Name, MonitorWork: right.Work},
+			monitors: []Monitor{primary, left},
+			want:     State{Bounds: Bounds{-1200, 300, 800, 600}, Monitor: left.Name, MonitorWork: left.Work},
+			ok:       true,
+		},
+		{
+			name:     "same monitor moved and shrunk",
+			state:    State{Bounds: Bounds{3000, 500, 800, 500}, Monitor: right.Name, MonitorWork: right.Work},
+			monitors: []Monitor{primary, left},
+			want:     State{Bounds: Bounds{-800, 684, 800, 500}, Monitor: left.Name, MonitorWork: left.Work},
+			ok:       true,
+		},
+		{
+			name:     "monitor removed with the window mostly visible",
+			state:    State{Bounds: Bounds{1800, 100, 800, 600}, Fullscreen: true, Monitor: right.Name, MonitorWork: right.Work},
+			monitors: []Monitor{primary, third},
+			want:     State{Bounds: Bounds{1920, 100, 800, 600}, Fullscreen: true, Monitor: third.Name, MonitorWork: third.Work},
+			ok:       true,
+		},
+		{
+			name:     "monitor removed with the window on the primary monitor",
+			state:    State{Bounds: Bounds{100, 100, 800, 600}, Monitor: `\\.\DISPLAY9`, MonitorWork: Bounds{0, 0, 1920, 1040}},
+			monitors: []Monitor{primary},
+			want:     State{Bounds: Bounds{100, 100, 800, 600}, Monitor: primary.Name, MonitorWork: primary.Work},
+			ok:       true,
+		},
+		{
+			name:     "monitor removed with the window off-screen",
+			state:    State{Bounds: Bounds{2000, 100, 800, 600}, Monitor: right.Name, MonitorWork: right.Work},
+			monitors: []Monitor{primary},
+			want:     State{Bounds: Bounds{80, 100, 800, 600}, Monitor: primary.Name, MonitorWork: primary.Work},
+			ok:       true,
+		},
+		{
+			name:     "monitor removed with the window off-screen beyond the primary work area",
+			state:    State{Bounds: Bounds{3500, 900, 800, 600}, Monitor: right.Name, MonitorWork: right.Work},
+			monitors: []Monitor{primary},
+			want:     State{Bounds: Bounds{1120, 440, 800, 600}, Monitor: primary.Name, MonitorWork: primary.Work},
+			ok:       true,
+		},
+		{
+			name:     "window too large for the work area",
+			state:    State{Bounds: Bounds{-100, -50, 3000, 2000}, Monitor: primary.Name, MonitorWork: primary.Work},
+			monitors: []Monitor{primary, right},
+			want:     State{Bounds: Bounds{0, 0, 1920, 1040}, Monitor: primary.Name, MonitorWork: primary.Work},
+			ok:       true,
+		},
+		{
+			name:     "window too wide for the work area",
+			state:    State{Bounds: Bounds{-1280, 300, 1600, 600}, Monitor: left.Name, MonitorWork: left.Work},
+			monitors: []Monitor{primary, left},
+			want:     State{Bounds: Bounds{-1280, 300, 1280, 600}, Monitor: left.Name, MonitorWork: left.Work},
+			ok:       true,
+		},
+		{
+			name:     "empty MonitorWork of a connected monitor",
+			state:    State{Bounds: Bounds{2000, 100, 800, 600}, Monitor: right.Name},
+			monitors: []Monitor{primary, right},
+			want:     State{Bounds: Bounds{2000, 100, 800, 600}, Monitor: right.Name, MonitorWork: right.Work},
+			ok:       true,
+		},
+		{
+			name:     "empty MonitorWork and Monitor with the window off-screen",
+			state:    State{Bounds: Bounds{-5000, -5000, 800, 600}},
+			monitors: []Monitor{primary, right},
+			want:     State{Bounds: Bounds{0, 0, 800, 600}, Monitor: primary.Name, MonitorWork: primary.Work},
+			ok:       true,
+		},
+		{
+			name:     "empty MonitorWork and Monitor with the window visible",
+			state:    State{Bounds: Bounds{2100, 200, 800, 600}},
+			monitors: []Monitor{primary, right},
+			want:     State{Bounds: Bounds{2100, 200, 800, 600}, Monitor: right.Name, MonitorWork: right.Work},
+			ok:       true,
+		},
+		{
+			name:     "empty monitor list",
+			state:    State{Bounds: Bounds{100, 100, 800, 600}, Monitor: primary.Name, MonitorWork: primary.Work},
+			monitors: nil,
+		},
+		{
+			name:     "empty bounds",
+			state:    State{Bounds: Bounds{100, 100, 0, 600}, Monitor: primary.Name, MonitorWork: primary.Work},
+			monitors: []Monitor{primary},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Clamp(tt.state, tt.monitors)
+			if ok != tt.ok {
+				t.Fatalf("Clamp() ok = %t, want %t", ok, tt.ok)
+			}
+			if got != tt.want {
+				t.Fatalf("Clamp() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app", "windows.json")
+	main := State{Bounds: Bounds{10, 20, 800, 600}, Maximised: true, Monitor: primary.Name, MonitorWork: primary.Work}
+	settings := State{Bounds: Bounds{30, 40, 400, 300}}
+
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("opening a missing store failed: %v", err)
+	}
+	if _, ok := s.Get("main"); ok {
+		t.Fatal("empty store has a state")
+	}
+	if err := s.Set("main", main); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("settings", settings); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := s.Get("main"); !ok || got != main {
+		t.Fatalf("Get(main) = %+v, %t, want %+v", got, ok, main)
+	}
+
+	if err := s.Reset("main"); err != nil {
+		t.Fatal(err)
+	}
+	s, _ = Open(path)
+	if _, ok := s.Get("main"); ok {
+		t.Fatal("Reset(main) kept the state")
+	}
+	if got, ok := s.Get("settings"); !ok || got != settings {
+		t.Fatalf("Get(settings) = %+v, %t, want %+v", got, ok, settings)
+	}
+
+	if err := s.Reset(""); err != nil {
+		t.Fatal(err)
+	}
+	s, _ = Open(path)
+	if _, ok := s.Get("settings"); ok {
+		t.Fatal("Reset of all states kept a state")
+	}
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("temporary file left behind: %v", err)
+	}
+}
+
+func TestOpenInvalidStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "windows.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(path); err == nil {
+		t.Fatal("opening an invalid store succeeded")
+	}
+}
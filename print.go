@@ -0,0 +1,83 @@
+package wv2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+)
+
+var ErrPrinterUnavailable = errors.New("wv2: printer unavailable")
+
+// PrintToPDF renders the current page as PDF. If the runtime doesn't support printing to a stream, the PDF is
+// printed to a temporary file instead. It must not be called on the UI thread.
+func (w *Window) PrintToPDF(ctx context.Context, settings *edge.PrintSettings) ([]byte, error) {
+	var pdf []byte
+	err := w.await(ctx, func(done func(error)) error {
+		return w.chromium.PrintToPdfStream(settings, func(data []byte, err error) {
+			pdf = data
+			done(err)
+		})
+	})
+	if !errors.Is(err, edge.ErrNotSupported) {
+		return pdf, err
+	}
+
+	dir, err := os.MkdirTemp("", "wv2-print")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "page.pdf")
+	if err := w.PrintToPDFFile(ctx, path, settings); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+// PrintToPDFReader is like PrintToPDF but returns a reader over the PDF.
+func (w *Window) PrintToPDFReader(ctx context.Context, settings *edge.PrintSettings) (io.Reader, error) {
+	pdf, err := w.PrintToPDF(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(pdf), nil
+}
+
+// PrintToPDFFile renders the current page as PDF to the file at path. It must not be called on the UI thread.
+func (w *Window) PrintToPDFFile(ctx context.Context, path string, settings *edge.PrintSettings) error {
+	return w.await(ctx, func(done func(error)) error {
+		return w.chromium.PrintToPdf(path, settings, done)
+	})
+}
+
+// Print prints the current page without asking the user. It must not be called on the UI thread.
+func (w *Window) Print(ctx context.Context, settings *edge.PrintSettings) error {
+	return w.await(ctx, func(done func(error)) error {
+		return w.chromium.Print(settings, func(status edge.COREWEBVIEW2_PRINT_STATUS, err error) {
+			if err == nil {
+				switch status {
+				case edge.COREWEBVIEW2_PRINT_STATUS_PRINTER_UNAVAILABLE:
+					err = ErrPrinterUnavailable
+				case edge.COREWEBVIEW2_PRINT_STATUS_OTHER_ERROR:
+					err = errors.New("wv2: printing failed")
+				}
+			}
+			done(err)
+		})
+	})
+}
+
+// ShowPrintUI opens the print dialog of the browser, or of the system if system is true.
+func (w *Window) ShowPrintUI(system bool) error {
+	kind := edge.COREWEBVIEW2_PRINT_DIALOG_KIND(edge.COREWEBVIEW2_PRINT_DIALOG_KIND_BROWSER)
+	if system {
+		kind = edge.COREWEBVIEW2_PRINT_DIALOG_KIND_SYSTEM
+	}
+	return w.chromium.ShowPrintUI(kind)
+}
@@ -0,0 +1,152 @@
+package wv2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/win32"
+	"github.com/b1naryth1ef/wv2/winc"
+)
+
+type ProcessKind int
+
+const (
+	ProcessBrowser ProcessKind = iota
+	ProcessRenderer
+	ProcessUtility
+	ProcessSandboxHelper
+	ProcessGPU
+	ProcessPPAPIPlugin
+	ProcessPPAPIBroker
+)
+
+func (k ProcessKind) String() string {
+	switch k {
+	case ProcessBrowser:
+		return "browser"
+	case ProcessRenderer:
+		return "renderer"
+	case ProcessUtility:
+		return "utility"
+	case ProcessSandboxHelper:
+		return "sandbox-helper"
+	case ProcessGPU:
+		return "gpu"
+	case ProcessPPAPIPlugin:
+		return "ppapi-plugin"
+	case ProcessPPAPIBroker:
+		return "ppapi-broker"
+	}
+	return "unknown"
+}
+
+// ProcessInfo describes one of the processes of the browser of a window and its resource usage. Windows sharing a
+// browser report the same processes.
+type ProcessInfo struct {
+	PID  uint32
+	Kind ProcessKind
+	// Frames rendered by the process, only reported for renderer processes by newer runtimes.
+	Frames []ProcessFrame
+
+	// WorkingSet is the physical memory used by the process in bytes.
+	WorkingSet uint64
+	// PrivateBytes is the memory committed by the process which isn't shared with other processes.
+	PrivateBytes uint64
+	// CPUTime is the CPU time the process used in kernel and user mode so far.
+	CPUTime time.Duration
+	Started time.Time
+	// UsageErr is set if the resource usage couldn't be queried, e.g. because the process exited in between.
+	UsageErr error
+}
+
+// ProcessFrame is a frame rendered by a renderer process.
+type ProcessFrame struct {
+	Name   string
+	Source string
+	// Main is set for the top level document of the window.
+	Main bool
+	// Frame is the iframe of the window, it is nil for the top level document and frames of other windows.
+	Frame *Frame
+}
+
+// OnProcessesChanged is fired on the UI thread when a process of the browser has been started or exited.
+func (w *Window) OnProcessesChanged() *winc.EventManager {
+	return &w.onProcessesChanged
+}
+
+func (w *Window) processInfosChanged() {
+	w.onProcessesChanged.Fire(winc.NewEvent(w, nil))
+}
+
+// BrowserProcessID returns the ID of the browser process of the window.
+func (w *Window) BrowserProcessID() (uint32, error) {
+	return w.chromium.BrowserProcessID()
+}
+
+// Processes returns the processes of the browser of the window together with their memory and CPU usage, e.g. to
+// show a task manager or to log the resource usage periodically. It must not be called on the UI thread.
+func (w *Window) Processes(ctx context.Context) ([]ProcessInfo, error) {
+	var infos []edge.ProcessInfo
+	var frameIDs map[uint32]*Frame
+	var mainFrameID uint32
+	err := w.await(ctx, func(done func(error)) error {
+		frameIDs, mainFrameID = w.runtimeFrameIDs()
+		err := w.chromium.ProcessExtendedInfos(func(result []edge.ProcessInfo, err error) {
+			infos = result
+			done(err)
+		})
+		if errors.Is(err, edge.ErrNotSupported) {
+			// Older runtimes don't report the frames of the processes.
+			infos, err = w.chromium.ProcessInfos()
+			done(err)
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	processes := make([]ProcessInfo, 0, len(infos))
+	for _, info := range infos {
+		p := ProcessInfo{
+			PID:  info.ProcessID,
+			Kind: ProcessKind(info.Kind),
+		}
+		for _, f := range info.Frames {
+			p.Frames = append(p.Frames, ProcessFrame{
+				Name:   f.Name,
+				Source: f.Source,
+				Main:   f.ID != 0 && f.ID == mainFrameID,
+				Frame:  frameIDs[f.ID],
+			})
+		}
+
+		usage, err := win32.GetProcessUsage(info.ProcessID)
+		if err != nil {
+			p.UsageErr = err
+		} else {
+			p.WorkingSet = usage.WorkingSet
+			p.PrivateBytes = usage.PrivateBytes
+			p.CPUTime = usage.KernelTime + usage.UserTime
+			p.Started = usage.Started
+		}
+		processes = append(processes, p)
+	}
+	return processes, nil
+}
+
+// runtimeFrameIDs maps the IDs the runtime assigned to the frames of the window, they are only known to newer
+// runtimes.
+func (w *Window) runtimeFrameIDs() (map[uint32]*Frame, uint32) {
+	frames := make(map[uint32]*Frame, len(w.frames))
+	for _, f := range w.frames {
+		if id, err := f.frame.RuntimeID(); err == nil && id != 0 {
+			frames[id] = f
+		}
+	}
+	mainFrameID, _ := w.chromium.MainFrameID()
+	return frames, mainFrameID
+}
@@ -0,0 +1,31 @@
+package wv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+)
+
+// ClearBrowsingData deletes the browsing data of the specified kinds from the profile of the window. If from and to
+// are zero all data is deleted, otherwise only the data stored in between.
+func (w *Window) ClearBrowsingData(ctx context.Context, dataKinds edge.COREWEBVIEW2_BROWSING_DATA_KINDS, from, to time.Time) error {
+	return w.await(ctx, func(done func(error)) error {
+		return w.chromium.ClearBrowsingData(dataKinds, from, to, done)
+	})
+}
+
+// ClearAllBrowsingData deletes all browsing data of the profile of the window, e.g. to sign out an account.
+func (w *Window) ClearAllBrowsingData(ctx context.Context) error {
+	return w.ClearBrowsingData(ctx, edge.COREWEBVIEW2_BROWSING_DATA_KINDS_ALL_PROFILE, time.Time{}, time.Time{})
+}
+
+// SetPreferredColorScheme overrides the prefers-color-scheme of all pages of the profile of the window.
+func (w *Window) SetPreferredColorScheme(scheme edge.COREWEBVIEW2_PREFERRED_COLOR_SCHEME) error {
+	return w.chromium.PutPreferredColorScheme(scheme)
+}
+
+// SetDownloadFolder sets the folder downloads are saved to if the ResultFilePath isn't changed.
+func (w *Window) SetDownloadFolder(path string) error {
+	return w.chromium.PutDefaultDownloadFolderPath(path)
+}
@@ -0,0 +1,65 @@
+package wv2
+
+// defaultResizeBorder is the width in DIP of the resize border of frameless windows if WindowOpts.ResizeBorder is 0.
+const defaultResizeBorder = 5
+
+// resizeEdge is the edge or corner of a frameless window which is dragged to resize it.
+type resizeEdge int
+
+const (
+	edgeNone resizeEdge = iota
+	edgeLeft
+	edgeRight
+	edgeTop
+	edgeTopLeft
+	edgeTopRight
+	edgeBottom
+	edgeBottomLeft
+	edgeBottomRight
+)
+
+// resizeEdgeNames are the names used by the frameless script, they match the CSS cursor names without -resize.
+var resizeEdgeNames = map[string]resizeEdge{
+	"":             edgeNone,
+	"left":         edgeLeft,
+	"right":        edgeRight,
+	"top":          edgeTop,
+	"top-left":     edgeTopLeft,
+	"top-right":    edgeTopRight,
+	"bottom":       edgeBottom,
+	"bottom-left":  edgeBottomLeft,
+	"bottom-right": edgeBottomRight,
+}
+
+// resizeEdgeAt returns the edge at x, y of a client area with the specified size, border is the width of the
+// resize border in the same unit. Corners take precedence over the edges.
+func resizeEdgeAt(x, y, width, height, border int) resizeEdge {
+	if border <= 0 || x < 0 || y < 0 || x >= width || y >= height {
+		return edgeNone
+	}
+
+	left, right := x < border, x >= width-border
+	switch {
+	case y < border:
+		switch {
+		case left:
+			return edgeTopLeft
+		case right:
+			return edgeTopRight
+		}
+		return edgeTop
+	case y >= height-border:
+		switch {
+		case left:
+			return edgeBottomLeft
+		case right:
+			return edgeBottomRight
+		}
+		return edgeBottom
+	case left:
+		return edgeLeft
+	case right:
+		return edgeRight
+	}
+	return edgeNone
+}
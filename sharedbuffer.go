@@ -0,0 +1,43 @@
+package wv2
+
+import (
+	"encoding/json"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+)
+
+type SharedBufferAccess int
+
+const (
+	// SharedBufferReadOnly only allows the page to read the buffer.
+	SharedBufferReadOnly SharedBufferAccess = edge.COREWEBVIEW2_SHARED_BUFFER_ACCESS_READ_ONLY
+	// SharedBufferReadWrite allows the page to write to the buffer, the changes are visible to Go.
+	SharedBufferReadWrite SharedBufferAccess = edge.COREWEBVIEW2_SHARED_BUFFER_ACCESS_READ_WRITE
+)
+
+// CreateSharedBuffer allocates memory of size bytes which can be posted to the page without copying it. The buffer
+// must be closed after the page and Go finished using it.
+func (w *Window) CreateSharedBuffer(size int) (*edge.SharedBuffer, error) {
+	return w.chromium.CreateSharedBuffer(uint64(size))
+}
+
+// PostSharedBuffer posts buffer to the page. metadata is marshalled to JSON and may be nil. The page receives the
+// buffer with:
+//
+//	window.chrome.webview.addEventListener("sharedbufferreceived", (e) => {
+//		const buffer = e.getBuffer(); // ArrayBuffer
+//		const metadata = e.additionalData;
+//	});
+//
+// The buffer can be posted multiple times, closing it detaches the ArrayBuffer in the page.
+func (w *Window) PostSharedBuffer(buffer *edge.SharedBuffer, access SharedBufferAccess, metadata interface{}) error {
+	var additionalData string
+	if metadata != nil {
+		data, err := json.Marshal(metadata)
+		if err != nil {
+			return err
+		}
+		additionalData = string(data)
+	}
+	return w.chromium.PostSharedBufferToScript(buffer, edge.COREWEBVIEW2_SHARED_BUFFER_ACCESS(access), additionalData)
+}
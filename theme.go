@@ -0,0 +1,122 @@
+package wv2
+
+import (
+	"fmt"
+	"log"
+	"unsafe"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/win32"
+	"github.com/b1naryth1ef/wv2/winc"
+	"github.com/b1naryth1ef/wv2/winc/w32"
+)
+
+type Theme int
+
+const (
+	// ThemeSystem follows the app mode chosen in the Windows settings.
+	ThemeSystem Theme = iota
+	ThemeLight
+	ThemeDark
+)
+
+func (t Theme) String() string {
+	switch t {
+	case ThemeSystem:
+		return "system"
+	case ThemeLight:
+		return "light"
+	case ThemeDark:
+		return "dark"
+	}
+	return "unknown"
+}
+
+// dark resolves the theme to dark or light, systemDark is the app mode of Windows.
+func (t Theme) dark(systemDark bool) bool {
+	switch t {
+	case ThemeLight:
+		return false
+	case ThemeDark:
+		return true
+	}
+	return systemDark
+}
+
+var preferredColorSchemes = map[Theme]edge.COREWEBVIEW2_PREFERRED_COLOR_SCHEME{
+	ThemeSystem: edge.COREWEBVIEW2_PREFERRED_COLOR_SCHEME_AUTO,
+	ThemeLight:  edge.COREWEBVIEW2_PREFERRED_COLOR_SCHEME_LIGHT,
+	ThemeDark:   edge.COREWEBVIEW2_PREFERRED_COLOR_SCHEME_DARK,
+}
+
+type ThemeEventData struct {
+	Theme Theme
+	// Dark is set if the window is drawn dark, either because of ThemeDark or because Windows uses the dark app mode.
+	Dark bool
+}
+
+// OnThemeChanged is fired when the window switches between dark and light, because SetTheme has been called or the
+// Windows app mode has changed. The page receives the wv2theme event with {theme, dark} as detail.
+func (w *Window) OnThemeChanged() *winc.EventManager {
+	return &w.onThemeChanged
+}
+
+// Theme returns the theme of the window and whether it is currently drawn dark.
+func (w *Window) Theme() (Theme, bool) {
+	return w.theme, w.dark
+}
+
+// SetTheme changes the theme of the title bar and the prefers-color-scheme of the pages. The color scheme applies
+// to all windows sharing the profile.
+func (w *Window) SetTheme(theme Theme) error {
+	scheme, ok := preferredColorSchemes[theme]
+	if !ok {
+		return fmt.Errorf("wv2: invalid theme %d", theme)
+	}
+	if err := w.chromium.PutPreferredColorScheme(scheme); err != nil {
+		return err
+	}
+	w.theme = theme
+	w.updateTheme()
+	return nil
+}
+
+func (w *Window) initTheme() {
+	w.theme = w.opts.Theme
+	w.dark = w.theme.dark(win32.IsCurrentlyDarkMode())
+	if err := win32.SetImmersiveDarkMode(w.Handle(), w.dark); err != nil {
+		log.Printf("Setting the dark mode of the title bar failed: %v", err)
+	}
+	if w.theme != ThemeSystem {
+		if err := w.chromium.PutPreferredColorScheme(preferredColorSchemes[w.theme]); err != nil {
+			log.Printf("Setting the preferred color scheme failed: %v", err)
+		}
+	}
+}
+
+// settingChanged handles WM_SETTINGCHANGE, lparam points to the name of the changed setting.
+func (w *Window) settingChanged(lparam uintptr) {
+	if lparam == 0 {
+		return
+	}
+	if w32.UTF16PtrToString(*(**uint16)(unsafe.Pointer(&lparam))) == "ImmersiveColorSet" {
+		w.updateTheme()
+	}
+}
+
+// updateTheme redraws the title bar and notifies the application and the page if the window switched between dark
+// and light.
+func (w *Window) updateTheme() {
+	dark := w.theme.dark(win32.IsCurrentlyDarkMode())
+	if dark == w.dark {
+		return
+	}
+	w.dark = dark
+	if err := win32.SetImmersiveDarkMode(w.Handle(), dark); err != nil {
+		log.Printf("Setting the dark mode of the title bar failed: %v", err)
+	}
+
+	data := &ThemeEventData{Theme: w.theme, Dark: dark}
+	w.onThemeChanged.Fire(winc.NewEvent(w, data))
+	w.emit("wv2theme", map[string]interface{}{"theme": w.theme.String(), "dark": dark})
+}
@@ -35,7 +35,7 @@ func CreateCoreWebView2Environment(environmentCompletedHandler ICoreWebView2Crea
 // user data folder, and with or without additional options.
 //
 // See https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl?#createcorewebview2environmentwithoptions
-func CreateCoreWebView2EnvironmentWithOptions(environmentCompletedHandler ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler, opts ...option) error {
+func CreateCoreWebView2EnvironmentWithOptions(environmentCompletedHandler ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler, opts ...Option) error {
 	var params environmentOptions
 	for _, opt := range opts {
 		opt(&params)
@@ -95,9 +95,7 @@ func createWebViewEnvironmentWithClientDll(lpLibFileName string, runtimeType web
 		return err
 	}
 
-	envOptionsCom := combridge.New2[iCoreWebView2EnvironmentOptions, iCoreWebView2EnvironmentOptions2](
-		envOptions, envOptions)
-
+	envOptionsCom := newEnvironmentOptionsCom(envOptions)
 	defer envOptionsCom.Close()
 
 	envCompletedHandler = &environmentCreatedHandler{envCompletedHandler}
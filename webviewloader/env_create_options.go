@@ -1,4 +1,4 @@
-//go:build windows && !native_webview2loader
+//go:build windows
 
 package webviewloader
 
@@ -21,7 +21,7 @@ import (
 // compatible version of the WebView2 Runtime that is installed on the user machine (first at the machine level,
 // and then per user) using the selected channel preference. The path of fixed version of the WebView2 Runtime
 // should not contain \Edge\Application\. When such a path is used, the API fails with HRESULT_FROM_WIN32(ERROR_NOT_SUPPORTED).
-func WithBrowserExecutableFolder(folder string) option {
+func WithBrowserExecutableFolder(folder string) Option {
 	return func(wvep *environmentOptions) {
 		wvep.browserExecutableFolder = folder
 	}
@@ -36,7 +36,7 @@ func WithBrowserExecutableFolder(folder string) option {
 // next to the compiled code for the app. WebView2 creation fails if the compiled code is running
 // in a directory in which the process does not have permission to create a new directory.
 // The app is responsible to clean up the associated user data folder when it is done.
-func WithUserDataFolder(folder string) option {
+func WithUserDataFolder(folder string) Option {
 	return func(wvep *environmentOptions) {
 		wvep.userDataFolder = folder
 	}
@@ -69,7 +69,7 @@ func WithUserDataFolder(folder string) option {
 // to run the browser process with no extra flags.
 //
 // [ChromiumDevelopersHowTosRunWithFlags]: https://www.chromium.org/developers/how-tos/run-chromium-with-flags "Run Chromium with flags | The Chromium Projects"
-func WithAdditionalBrowserArguments(args string) option {
+func WithAdditionalBrowserArguments(args string) Option {
 	return func(wvep *environmentOptions) {
 		wvep.additionalBrowserArguments = args
 	}
@@ -89,7 +89,7 @@ func WithAdditionalBrowserArguments(args string) option {
 //
 // [ISO639LanguageCodesHtml]: https://www.iso.org/iso-639-language-codes.html "ISO 639 | ISO"
 // [ISOStandard72482Html]: https://www.iso.org/standard/72482.html "ISO 3166-1:2020 | ISO"
-func WithLanguage(lang string) option {
+func WithLanguage(lang string) Option {
 	return func(wvep *environmentOptions) {
 		wvep.language = lang
 	}
@@ -108,7 +108,7 @@ func WithLanguage(lang string) option {
 // `TargetCompatibleBrowserVersion`. The binaries are only guaranteed to be
 // compatible. Verify the actual version on the `BrowserVersionString`
 // property on the `ICoreWebView2Environment`.
-func WithTargetCompatibleBrowserVersion(version string) option {
+func WithTargetCompatibleBrowserVersion(version string) Option {
 	return func(wvep *environmentOptions) {
 		wvep.targetCompatibleBrowserVersion = version
 	}
@@ -125,7 +125,7 @@ func WithTargetCompatibleBrowserVersion(version string) option {
 // for the single sign on (SSO) to work.
 //
 // [WindowsUwpPackagingAppCapabilityDeclarationsRestrictedCapabilities]: /windows/uwp/packaging/app-capability-declarations\#restricted-capabilities "Restricted capabilities - App capability declarations | Microsoft Docs"
-func WithAllowSingleSignOnUsingOSPrimaryAccount(allow bool) option {
+func WithAllowSingleSignOnUsingOSPrimaryAccount(allow bool) Option {
 	return func(wvep *environmentOptions) {
 		wvep.allowSingleSignOnUsingOSPrimaryAccount = allow
 	}
@@ -143,16 +143,56 @@ func WithAllowSingleSignOnUsingOSPrimaryAccount(allow bool) option {
 // WebViews that have the same UserDataFolder. When another process tries to create a
 // WebView2Controller from an WebView2Environment object created with the same user data folder,
 // it will fail with `HRESULT_FROM_WIN32(ERROR_INVALID_STATE)`.
-func WithExclusiveUserDataFolderAccess(exclusive bool) option {
+func WithExclusiveUserDataFolderAccess(exclusive bool) Option {
 	return func(wvep *environmentOptions) {
 		wvep.exclusiveUserDataFolderAccess = exclusive
 	}
 }
 
-type option func(*environmentOptions)
+// WithCustomSchemeRegistrations registers custom schemes, e.g. `app`, whose requests are answered by the
+// application through WebResourceRequested instead of the network.
+//
+// All environments sharing the user data folder must use the same registrations.
+func WithCustomSchemeRegistrations(registrations ...CustomSchemeRegistration) Option {
+	return func(wvep *environmentOptions) {
+		wvep.customSchemeRegistrations = append(wvep.customSchemeRegistrations, registrations...)
+	}
+}
+
+// WithCustomCrashReporting disables sending crash dumps to Microsoft, so the application can collect them from
+// the `Crashpad\reports` folder of the user data folder itself.
+func WithCustomCrashReporting(enabled bool) Option {
+	return func(wvep *environmentOptions) {
+		wvep.isCustomCrashReportingEnabled = enabled
+	}
+}
+
+// WithTrackingPrevention enables or disables the tracking prevention of the WebView, which is enabled by default.
+// Disabling it improves the performance if the WebView only shows content of the application.
+func WithTrackingPrevention(enabled bool) Option {
+	return func(wvep *environmentOptions) {
+		wvep.disableTrackingPrevention = !enabled
+	}
+}
+
+// WithBrowserExtensions allows to install browser extensions in the profiles of the environment.
+func WithBrowserExtensions(enabled bool) Option {
+	return func(wvep *environmentOptions) {
+		wvep.areBrowserExtensionsEnabled = enabled
+	}
+}
+
+// Option configures the environment created by CreateCoreWebView2EnvironmentWithOptions.
+type Option func(*environmentOptions)
+
+const kMinimumCompatibleVersion = "86.0.616.0"
 
 var _ iCoreWebView2EnvironmentOptions = &environmentOptions{}
 var _ iCoreWebView2EnvironmentOptions2 = &environmentOptions{}
+var _ iCoreWebView2EnvironmentOptions3 = &environmentOptions{}
+var _ iCoreWebView2EnvironmentOptions4 = &environmentOptions{}
+var _ iCoreWebView2EnvironmentOptions5 = &environmentOptions{}
+var _ iCoreWebView2EnvironmentOptions6 = &environmentOptions{}
 
 type environmentOptions struct {
 	browserExecutableFolder string
@@ -164,6 +204,22 @@ type environmentOptions struct {
 	targetCompatibleBrowserVersion         string
 	allowSingleSignOnUsingOSPrimaryAccount bool
 	exclusiveUserDataFolderAccess          bool
+	customSchemeRegistrations              []CustomSchemeRegistration
+	isCustomCrashReportingEnabled          bool
+	disableTrackingPrevention              bool
+	areBrowserExtensionsEnabled            bool
+}
+
+// newEnvironmentOptionsCom returns the ComObject of the options, which must be closed after the environment has
+// been created.
+func newEnvironmentOptionsCom(o *environmentOptions) *combridge.ComObject[iCoreWebView2EnvironmentOptions] {
+	return combridge.NewN[iCoreWebView2EnvironmentOptions](o,
+		combridge.Implements[iCoreWebView2EnvironmentOptions2](o),
+		combridge.Implements[iCoreWebView2EnvironmentOptions3](o),
+		combridge.Implements[iCoreWebView2EnvironmentOptions4](o),
+		combridge.Implements[iCoreWebView2EnvironmentOptions5](o),
+		combridge.Implements[iCoreWebView2EnvironmentOptions6](o),
+	)
 }
 
 func (o *environmentOptions) AdditionalBrowserArguments() string {
@@ -190,6 +246,22 @@ func (o *environmentOptions) ExclusiveUserDataFolderAccess() bool {
 	return o.exclusiveUserDataFolderAccess
 }
 
+func (o *environmentOptions) IsCustomCrashReportingEnabled() bool {
+	return o.isCustomCrashReportingEnabled
+}
+
+func (o *environmentOptions) CustomSchemeRegistrations() []CustomSchemeRegistration {
+	return o.customSchemeRegistrations
+}
+
+func (o *environmentOptions) EnableTrackingPrevention() bool {
+	return !o.disableTrackingPrevention
+}
+
+func (o *environmentOptions) AreBrowserExtensionsEnabled() bool {
+	return o.areBrowserExtensionsEnabled
+}
+
 type iCoreWebView2EnvironmentOptions interface {
 	combridge.IUnknown
 
@@ -205,6 +277,30 @@ type iCoreWebView2EnvironmentOptions2 interface {
 	ExclusiveUserDataFolderAccess() bool
 }
 
+type iCoreWebView2EnvironmentOptions3 interface {
+	combridge.IUnknown
+
+	IsCustomCrashReportingEnabled() bool
+}
+
+type iCoreWebView2EnvironmentOptions4 interface {
+	combridge.IUnknown
+
+	CustomSchemeRegistrations() []CustomSchemeRegistration
+}
+
+type iCoreWebView2EnvironmentOptions5 interface {
+	combridge.IUnknown
+
+	EnableTrackingPrevention() bool
+}
+
+type iCoreWebView2EnvironmentOptions6 interface {
+	combridge.IUnknown
+
+	AreBrowserExtensionsEnabled() bool
+}
+
 func init() {
 	combridge.RegisterVTable[combridge.IUnknown, iCoreWebView2EnvironmentOptions](
 		"{2fde08a8-1e9a-4766-8c05-95a9ceb9d1c5}",
@@ -223,6 +319,30 @@ func init() {
 		_iCoreWebView2EnvironmentOptions2ExclusiveUserDataFolderAccess,
 		_iCoreWebView2EnvironmentOptionsNOP,
 	)
+
+	combridge.RegisterVTable[combridge.IUnknown, iCoreWebView2EnvironmentOptions3](
+		"{4a5c436e-a9e3-4a2e-89c3-910d3513f5cc}",
+		_iCoreWebView2EnvironmentOptions3IsCustomCrashReportingEnabled,
+		_iCoreWebView2EnvironmentOptionsNOP,
+	)
+
+	combridge.RegisterVTable[combridge.IUnknown, iCoreWebView2EnvironmentOptions4](
+		"{ac52d13f-0d38-475a-9dca-876580d6793e}",
+		_iCoreWebView2EnvironmentOptions4GetCustomSchemeRegistrations,
+		_iCoreWebView2EnvironmentOptionsNOP,
+	)
+
+	combridge.RegisterVTable[combridge.IUnknown, iCoreWebView2EnvironmentOptions5](
+		"{0ae35d64-c47f-4464-814e-259c345d1501}",
+		_iCoreWebView2EnvironmentOptions5EnableTrackingPrevention,
+		_iCoreWebView2EnvironmentOptionsNOP,
+	)
+
+	combridge.RegisterVTable[combridge.IUnknown, iCoreWebView2EnvironmentOptions6](
+		"{57d29cc3-c84f-42a0-b0e2-effbd5e179de}",
+		_iCoreWebView2EnvironmentOptions6AreBrowserExtensionsEnabled,
+		_iCoreWebView2EnvironmentOptionsNOP,
+	)
 }
 func _iCoreWebView2EnvironmentOptionsNOP(this uintptr) uintptr {
 	return uintptr(windows.S_FALSE)
@@ -258,6 +378,31 @@ func _iCoreWebView2EnvironmentOptions2ExclusiveUserDataFolderAccess(this uintptr
 	return uintptr(windows.S_OK)
 }
 
+func _iCoreWebView2EnvironmentOptions3IsCustomCrashReportingEnabled(this uintptr, value *int32) uintptr {
+	v := combridge.Resolve[iCoreWebView2EnvironmentOptions3](this).IsCustomCrashReportingEnabled()
+	*value = boolToInt(v)
+	return uintptr(windows.S_OK)
+}
+
+func _iCoreWebView2EnvironmentOptions4GetCustomSchemeRegistrations(this uintptr, count *uint32, value **uintptr) uintptr {
+	regs := combridge.Resolve[iCoreWebView2EnvironmentOptions4](this).CustomSchemeRegistrations()
+	*count = uint32(len(regs))
+	*value = newCustomSchemeRegistrationArray(regs)
+	return uintptr(windows.S_OK)
+}
+
+func _iCoreWebView2EnvironmentOptions5EnableTrackingPrevention(this uintptr, value *int32) uintptr {
+	v := combridge.Resolve[iCoreWebView2EnvironmentOptions5](this).EnableTrackingPrevention()
+	*value = boolToInt(v)
+	return uintptr(windows.S_OK)
+}
+
+func _iCoreWebView2EnvironmentOptions6AreBrowserExtensionsEnabled(this uintptr, value *int32) uintptr {
+	v := combridge.Resolve[iCoreWebView2EnvironmentOptions6](this).AreBrowserExtensionsEnabled()
+	*value = boolToInt(v)
+	return uintptr(windows.S_OK)
+}
+
 func stringToOleString(v string) *uint16 {
 	wstr := utf16.Encode([]rune(v + "\x00"))
 	lwstr := len(wstr)
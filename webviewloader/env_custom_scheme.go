@@ -0,0 +1,123 @@
+//go:build windows
+
+package webviewloader
+
+import (
+	"unsafe"
+
+	"github.com/b1naryth1ef/wv2/pkg/combridge"
+	"golang.org/x/sys/windows"
+)
+
+// CustomSchemeRegistration registers a custom scheme, e.g. `app`, with the WebView2 environment so that requests
+// for it can be answered through WebResourceRequested.
+//
+// See https://learn.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2customschemeregistration
+type CustomSchemeRegistration struct {
+	// SchemeName is the name of the scheme without the colon, it must be lower-case.
+	SchemeName string
+	// TreatAsSecure treats the scheme like https, so that pages of the scheme are a secure context.
+	TreatAsSecure bool
+	// AllowedOrigins are the origins which may issue requests for the scheme, e.g. with fetch. Pages of the scheme
+	// itself are always allowed. "*" allows all origins.
+	AllowedOrigins []string
+	// HasAuthorityComponent means URIs of the scheme look like `app://host/path`. Without an authority component
+	// all pages of the scheme share the opaque origin `null`.
+	HasAuthorityComponent bool
+}
+
+type customSchemeRegistration struct {
+	reg CustomSchemeRegistration
+}
+
+func (r *customSchemeRegistration) SchemeName() string {
+	return r.reg.SchemeName
+}
+
+func (r *customSchemeRegistration) TreatAsSecure() bool {
+	return r.reg.TreatAsSecure
+}
+
+func (r *customSchemeRegistration) AllowedOrigins() []string {
+	return r.reg.AllowedOrigins
+}
+
+func (r *customSchemeRegistration) HasAuthorityComponent() bool {
+	return r.reg.HasAuthorityComponent
+}
+
+type iCoreWebView2CustomSchemeRegistration interface {
+	combridge.IUnknown
+
+	SchemeName() string
+	TreatAsSecure() bool
+	AllowedOrigins() []string
+	HasAuthorityComponent() bool
+}
+
+func init() {
+	combridge.RegisterVTable[combridge.IUnknown, iCoreWebView2CustomSchemeRegistration](
+		"{d60ac92c-37a6-4b26-a39e-95cfe59047bb}",
+		_iCoreWebView2CustomSchemeRegistrationSchemeName,
+		_iCoreWebView2CustomSchemeRegistrationTreatAsSecure,
+		_iCoreWebView2EnvironmentOptionsNOP,
+		_iCoreWebView2CustomSchemeRegistrationGetAllowedOrigins,
+		_iCoreWebView2EnvironmentOptionsNOP,
+		_iCoreWebView2CustomSchemeRegistrationHasAuthorityComponent,
+		_iCoreWebView2EnvironmentOptionsNOP,
+	)
+}
+
+func _iCoreWebView2CustomSchemeRegistrationSchemeName(this uintptr, value **uint16) uintptr {
+	v := combridge.Resolve[iCoreWebView2CustomSchemeRegistration](this).SchemeName()
+	*value = stringToOleString(v)
+	return uintptr(windows.S_OK)
+}
+
+func _iCoreWebView2CustomSchemeRegistrationTreatAsSecure(this uintptr, value *int32) uintptr {
+	v := combridge.Resolve[iCoreWebView2CustomSchemeRegistration](this).TreatAsSecure()
+	*value = boolToInt(v)
+	return uintptr(windows.S_OK)
+}
+
+func _iCoreWebView2CustomSchemeRegistrationGetAllowedOrigins(this uintptr, count *uint32, value ***uint16) uintptr {
+	origins := combridge.Resolve[iCoreWebView2CustomSchemeRegistration](this).AllowedOrigins()
+	*count = uint32(len(origins))
+	*value = nil
+	if len(origins) == 0 {
+		return uintptr(windows.S_OK)
+	}
+
+	// The array and the strings are owned and freed by the caller.
+	array := unsafe.Slice((**uint16)(coTaskMemAlloc(len(origins)*int(unsafe.Sizeof(uintptr(0))))), len(origins))
+	for i, origin := range origins {
+		array[i] = stringToOleString(origin)
+	}
+	*value = &array[0]
+	return uintptr(windows.S_OK)
+}
+
+func _iCoreWebView2CustomSchemeRegistrationHasAuthorityComponent(this uintptr, value *int32) uintptr {
+	v := combridge.Resolve[iCoreWebView2CustomSchemeRegistration](this).HasAuthorityComponent()
+	*value = boolToInt(v)
+	return uintptr(windows.S_OK)
+}
+
+// newCustomSchemeRegistrationArray returns a CoTaskMem allocated array of ICoreWebView2CustomSchemeRegistration
+// pointers, which is owned by the caller together with one reference to every registration.
+func newCustomSchemeRegistrationArray(regs []CustomSchemeRegistration) *uintptr {
+	if len(regs) == 0 {
+		return nil
+	}
+
+	array := unsafe.Slice((*uintptr)(coTaskMemAlloc(len(regs)*int(unsafe.Sizeof(uintptr(0))))), len(regs))
+	for i, reg := range regs {
+		obj := combridge.New[iCoreWebView2CustomSchemeRegistration](&customSchemeRegistration{reg})
+		ref := obj.Ref()
+		// Hand over a reference to the caller, the one of the ComObject is dropped by Close.
+		combridge.IUnknownFromUintptr(ref).AddRef()
+		obj.Close()
+		array[i] = ref
+	}
+	return &array[0]
+}
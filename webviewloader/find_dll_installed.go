@@ -9,9 +9,8 @@ import (
 )
 
 const (
-	kNumChannels              = 4
-	kInstallKeyPath           = "Software\\Microsoft\\EdgeUpdate\\ClientState\\"
-	kMinimumCompatibleVersion = "86.0.616.0"
+	kNumChannels    = 4
+	kInstallKeyPath = "Software\\Microsoft\\EdgeUpdate\\ClientState\\"
 )
 
 var (
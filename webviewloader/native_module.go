@@ -118,18 +118,27 @@ func GetAvailableCoreWebView2BrowserVersionString(path string) (string, error) {
 }
 
 // CreateCoreWebView2EnvironmentWithOptions tries to load WebviewLoader2 and
-// call the CreateCoreWebView2EnvironmentWithOptions routine.
-func CreateCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataFolder *uint16, environmentCompletedHandle uintptr, additionalBrowserArgs string) (uintptr, error) {
+// call the CreateCoreWebView2EnvironmentWithOptions routine. The browser executable and user data folder options
+// are ignored in favour of the parameters.
+func CreateCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataFolder *uint16, environmentCompletedHandle uintptr, opts ...Option) (uintptr, error) {
 	err := loadFromMemory()
 	if err != nil {
 		return 0, err
 	}
 
-	preventEnvAndRegistryOverrides(browserExecutableFolder, userDataFolder, additionalBrowserArgs)
+	var params environmentOptions
+	for _, opt := range opts {
+		opt(&params)
+	}
+
+	envOptionsCom := newEnvironmentOptionsCom(&params)
+	defer envOptionsCom.Close()
+
+	preventEnvAndRegistryOverrides(browserExecutableFolder, userDataFolder, params.additionalBrowserArguments)
 	res, _, _ := memCreate.Call(
 		uint64(uintptr(unsafe.Pointer(browserExecutableFolder))),
 		uint64(uintptr(unsafe.Pointer(userDataFolder))),
-		0,
+		uint64(envOptionsCom.Ref()),
 		uint64(environmentCompletedHandle),
 	)
 	return uintptr(res), nil
@@ -44,4 +44,6 @@ var (
 	kernelGlobalLock   = kernel32.NewProc("GlobalLock")
 	kernelGlobalUnlock = kernel32.NewProc("GlobalUnlock")
 	kernelLstrcpy      = kernel32.NewProc("lstrcpyW")
+
+	kernelGetProcessMemoryInfo = kernel32.NewProc("K32GetProcessMemoryInfo")
 )
@@ -0,0 +1,79 @@
+//go:build windows
+
+package win32
+
+import (
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// https://learn.microsoft.com/en-us/windows/win32/api/psapi/ns-psapi-process_memory_counters_ex
+type PROCESS_MEMORY_COUNTERS_EX struct {
+	Cb                         uint32
+	PageFaultCount             uint32
+	PeakWorkingSetSize         uintptr
+	WorkingSetSize             uintptr
+	QuotaPeakPagedPoolUsage    uintptr
+	QuotaPagedPoolUsage        uintptr
+	QuotaPeakNonPagedPoolUsage uintptr
+	QuotaNonPagedPoolUsage     uintptr
+	PagefileUsage              uintptr
+	PeakPagefileUsage          uintptr
+	PrivateUsage               uintptr
+}
+
+// ProcessUsage is the resource usage of a process.
+type ProcessUsage struct {
+	// WorkingSet is the physical memory currently used by the process in bytes.
+	WorkingSet uint64
+	// PeakWorkingSet is the highest WorkingSet since the process started.
+	PeakWorkingSet uint64
+	// PrivateBytes is the memory committed by the process which can't be shared with other processes.
+	PrivateBytes uint64
+	// KernelTime and UserTime is the CPU time the process used so far.
+	KernelTime time.Duration
+	UserTime   time.Duration
+	// Started is when the process has been created.
+	Started time.Time
+}
+
+// GetProcessUsage returns the memory and CPU usage of the process with the specified ID.
+func GetProcessUsage(pid uint32) (ProcessUsage, error) {
+	process, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION|windows.PROCESS_VM_READ, false, pid)
+	if err != nil {
+		return ProcessUsage{}, err
+	}
+	defer windows.CloseHandle(process)
+
+	var counters PROCESS_MEMORY_COUNTERS_EX
+	counters.Cb = uint32(unsafe.Sizeof(counters))
+	r, _, err := kernelGetProcessMemoryInfo.Call(
+		uintptr(process),
+		uintptr(unsafe.Pointer(&counters)),
+		uintptr(counters.Cb),
+	)
+	if r == 0 {
+		return ProcessUsage{}, err
+	}
+
+	var creation, exit, kernel, user windows.Filetime
+	if err := windows.GetProcessTimes(process, &creation, &exit, &kernel, &user); err != nil {
+		return ProcessUsage{}, err
+	}
+
+	return ProcessUsage{
+		WorkingSet:     uint64(counters.WorkingSetSize),
+		PeakWorkingSet: uint64(counters.PeakWorkingSetSize),
+		PrivateBytes:   uint64(counters.PrivateUsage),
+		KernelTime:     filetimeDuration(kernel),
+		UserTime:       filetimeDuration(user),
+		Started:        time.Unix(0, creation.Nanoseconds()),
+	}, nil
+}
+
+// filetimeDuration converts a FILETIME holding a duration in 100ns units.
+func filetimeDuration(ft windows.Filetime) time.Duration {
+	return time.Duration(uint64(ft.HighDateTime)<<32|uint64(ft.LowDateTime)) * 100
+}
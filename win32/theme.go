@@ -3,6 +3,7 @@
 package win32
 
 import (
+	"syscall"
 	"unsafe"
 
 	"golang.org/x/sys/windows/registry"
@@ -12,6 +13,7 @@ type DWMWINDOWATTRIBUTE int32
 
 const DwmwaUseImmersiveDarkModeBefore20h1 DWMWINDOWATTRIBUTE = 19
 const DwmwaUseImmersiveDarkMode DWMWINDOWATTRIBUTE = 20
+const DwmwaWindowCornerPreference DWMWINDOWATTRIBUTE = 33
 const DwmwaBorderColor DWMWINDOWATTRIBUTE = 34
 const DwmwaCaptionColor DWMWINDOWATTRIBUTE = 35
 const DwmwaTextColor DWMWINDOWATTRIBUTE = 36
@@ -23,16 +25,44 @@ const HCF_HIGHCONTRASTON = 0x00000001
 // BackdropType defines the type of translucency we wish to use
 type BackdropType int32
 
-func dwmSetWindowAttribute(hwnd uintptr, dwAttribute DWMWINDOWATTRIBUTE, pvAttribute unsafe.Pointer, cbAttribute uintptr) {
-	ret, _, err := procDwmSetWindowAttribute.Call(
+const (
+	DwmsbtAuto            BackdropType = 0
+	DwmsbtNone            BackdropType = 1
+	DwmsbtMainWindow      BackdropType = 2 // Mica
+	DwmsbtTransientWindow BackdropType = 3 // Acrylic
+	DwmsbtTabbedWindow    BackdropType = 4 // Mica Alt
+)
+
+// CornerPreference defines the rounding of the window corners on Windows 11
+type CornerPreference int32
+
+const (
+	DwmwcpDefault    CornerPreference = 0
+	DwmwcpDoNotRound CornerPreference = 1
+	DwmwcpRound      CornerPreference = 2
+	DwmwcpRoundSmall CornerPreference = 3
+)
+
+func dwmSetWindowAttribute(hwnd uintptr, dwAttribute DWMWINDOWATTRIBUTE, pvAttribute unsafe.Pointer, cbAttribute uintptr) error {
+	ret, _, _ := procDwmSetWindowAttribute.Call(
 		hwnd,
 		uintptr(dwAttribute),
 		uintptr(pvAttribute),
 		cbAttribute)
 	if ret != 0 {
-		_ = err
-		// println(err.Error())
+		return syscall.Errno(ret)
 	}
+	return nil
+}
+
+// SetSystemBackdropType fails on Windows versions before 11 22H2 (build 22621).
+func SetSystemBackdropType(hwnd uintptr, backdrop BackdropType) error {
+	return dwmSetWindowAttribute(hwnd, DwmwaSystemBackdropType, unsafe.Pointer(&backdrop), unsafe.Sizeof(backdrop))
+}
+
+// SetWindowCornerPreference fails on Windows versions before 11.
+func SetWindowCornerPreference(hwnd uintptr, preference CornerPreference) error {
+	return dwmSetWindowAttribute(hwnd, DwmwaWindowCornerPreference, unsafe.Pointer(&preference), unsafe.Sizeof(preference))
 }
 
 // func SupportsThemes() bool {
@@ -74,6 +104,20 @@ func dwmSetWindowAttribute(hwnd uintptr, dwAttribute DWMWINDOWATTRIBUTE, pvAttri
 // 	}
 // }
 
+// SetImmersiveDarkMode switches the title bar and the frame of the window between the dark and light style. Windows
+// 10 builds before 20H1 only know the undocumented attribute 19.
+func SetImmersiveDarkMode(hwnd uintptr, dark bool) error {
+	var value int32
+	if dark {
+		value = 1
+	}
+	err := dwmSetWindowAttribute(hwnd, DwmwaUseImmersiveDarkMode, unsafe.Pointer(&value), unsafe.Sizeof(value))
+	if err != nil {
+		err = dwmSetWindowAttribute(hwnd, DwmwaUseImmersiveDarkModeBefore20h1, unsafe.Pointer(&value), unsafe.Sizeof(value))
+	}
+	return err
+}
+
 func SetTitleBarColour(hwnd uintptr, titleBarColour int32) {
 	dwmSetWindowAttribute(hwnd, DwmwaCaptionColor, unsafe.Pointer(&titleBarColour), unsafe.Sizeof(titleBarColour))
 }
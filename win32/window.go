@@ -96,6 +96,13 @@ func ExtendFrameIntoClientArea(hwnd uintptr, extend bool) {
 	}
 }
 
+// ExtendFrameIntoWholeClientArea lets DWM draw the system backdrop behind the whole client area, which shows
+// through wherever the window draws black or transparent pixels.
+func ExtendFrameIntoWholeClientArea(hwnd uintptr) error {
+	margins := MARGINS{-1, -1, -1, -1}
+	return dwmExtendFrameIntoClientArea(hwnd, &margins)
+}
+
 func IsVisible(hwnd uintptr) bool {
 	ret, _, _ := procIsWindowVisible.Call(hwnd)
 	return ret != 0
@@ -15,7 +15,7 @@ import (
 	"github.com/b1naryth1ef/wv2/winc/w32"
 )
 
-func genOFN(parent Controller, title, filter string, filterIndex uint, initialDir string, buf []uint16) *w32.OPENFILENAME {
+func genOFN(parent Controller, title, filter string, filterIndex uint, initialDir string, buf []uint16, flags uint32) *w32.OPENFILENAME {
 	var ofn w32.OPENFILENAME
 	ofn.StructSize = uint32(unsafe.Sizeof(ofn))
 	ofn.Owner = parent.Handle()
@@ -43,13 +43,13 @@ func genOFN(parent Controller, title, filter string, filterIndex uint, initialDi
 		ofn.Title = syscall.StringToUTF16Ptr(title)
 	}
 
-	ofn.Flags = w32.OFN_FILEMUSTEXIST
+	ofn.Flags = flags
 	return &ofn
 }
 
 func ShowOpenFileDlg(parent Controller, title, filter string, filterIndex uint, initialDir string) (filePath string, accepted bool) {
 	buf := make([]uint16, 1024)
-	ofn := genOFN(parent, title, filter, filterIndex, initialDir, buf)
+	ofn := genOFN(parent, title, filter, filterIndex, initialDir, buf, w32.OFN_FILEMUSTEXIST)
 
 	if accepted = w32.GetOpenFileName(ofn); accepted {
 		filePath = syscall.UTF16ToString(buf)
@@ -59,7 +59,24 @@ func ShowOpenFileDlg(parent Controller, title, filter string, filterIndex uint,
 
 func ShowSaveFileDlg(parent Controller, title, filter string, filterIndex uint, initialDir string) (filePath string, accepted bool) {
 	buf := make([]uint16, 1024)
-	ofn := genOFN(parent, title, filter, filterIndex, initialDir, buf)
+	ofn := genOFN(parent, title, filter, filterIndex, initialDir, buf, w32.OFN_FILEMUSTEXIST)
+
+	if accepted = w32.GetSaveFileName(ofn); accepted {
+		filePath = syscall.UTF16ToString(buf)
+	}
+	return
+}
+
+// ShowSaveFileDlgWithName asks for the path to save a file at, fileName is the suggested name of the file. The user
+// is asked before an existing file is replaced.
+func ShowSaveFileDlgWithName(parent Controller, title, filter string, filterIndex uint, initialDir, fileName string) (filePath string, accepted bool) {
+	name := syscall.StringToUTF16(fileName)
+	buf := make([]uint16, 1024)
+	if len(name) > len(buf) {
+		buf = make([]uint16, len(name))
+	}
+	copy(buf, name)
+	ofn := genOFN(parent, title, filter, filterIndex, initialDir, buf, w32.OFN_OVERWRITEPROMPT|w32.OFN_PATHMUSTEXIST)
 
 	if accepted = w32.GetSaveFileName(ofn); accepted {
 		filePath = syscall.UTF16ToString(buf)
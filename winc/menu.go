@@ -205,6 +205,27 @@ func (mi *MenuItem) OnClick() *EventManager {
 	return &mi.onClick
 }
 
+// Items returns the items of a context menu or submenu.
+func (mi *MenuItem) Items() []*MenuItem {
+	if mi.hSubMenu == 0 {
+		return nil
+	}
+	return menuItems[mi.hSubMenu]
+}
+
+// TrackPopup shows the context menu at the given screen coordinates and returns the item that was clicked or nil
+// if the menu was dismissed. OnClick of the item is not fired.
+func (mi *MenuItem) TrackPopup(hwnd w32.HWND, x, y int) *MenuItem {
+	id := w32.TrackPopupMenuEx(
+		mi.hSubMenu,
+		w32.TPM_NOANIMATION|w32.TPM_RETURNCMD,
+		int32(x),
+		int32(y),
+		hwnd,
+		nil)
+	return findMenuItemByID(int(id))
+}
+
 func (mi *MenuItem) AddSeparator() {
 	addMenuItem(mi.hSubMenu, 0, "-", Shortcut{}, nil, false)
 }
@@ -296,6 +317,8 @@ func (mi *MenuItem) update() {
 }
 
 func (mi *MenuItem) IsSeparator() bool { return mi.text == "-" }
+func (mi *MenuItem) IsSubMenu() bool   { return mi.hSubMenu != 0 }
+func (mi *MenuItem) IsRadio() bool     { return mi.isRadio }
 func (mi *MenuItem) SetSeparator()     { mi.text = "-" }
 
 func (mi *MenuItem) Enabled() bool     { return mi.enabled }
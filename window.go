@@ -1,25 +1,121 @@
 package wv2
 
 import (
+	"encoding/json"
 	"log"
+	"reflect"
 	"unsafe"
 
 	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/pkg/externaluri"
+	"github.com/b1naryth1ef/wv2/pkg/permissions"
+	"github.com/b1naryth1ef/wv2/pkg/pinning"
+	"github.com/b1naryth1ef/wv2/pkg/shortcut"
+	"github.com/b1naryth1ef/wv2/pkg/windowstate"
 	"github.com/b1naryth1ef/wv2/win32"
 	"github.com/b1naryth1ef/wv2/winc"
 	"github.com/b1naryth1ef/wv2/winc/w32"
 )
 
 type WindowOpts struct {
-	Frameless      bool
+	Title string
+	Icon  *winc.Icon
+
+	// Frameless hides the title bar and border of the window. Elements with the CSS property app-region: drag or
+	// the data-wv2-drag attribute move the window, data-wv2-no-drag and app-region: no-drag exclude their children.
+	Frameless bool
+	// ResizeBorder is the width in DIP of the border which resizes frameless windows, it defaults to 5. Negative
+	// values prevent the user from resizing the window.
+	ResizeBorder int
+	// Decorations drawn by the system, e.g. the shadow of frameless windows.
+	Decorations Decorations
+	// Backdrop is the translucent material behind the window, it shows through a transparent page background.
+	Backdrop Backdrop
+	// Theme of the title bar and the prefers-color-scheme of the pages. See OnThemeChanged.
+	Theme Theme
+
 	MinimizeOnQuit bool
 
 	InitialURL    string
 	InitialWidth  int
 	InitialHeight int
 
+	// ProfileName isolates the cookies, storage and caches of the window from windows with other profiles.
+	ProfileName string
+	// InPrivate doesn't persist any browsing data of the window on disk.
+	InPrivate bool
+	// DownloadFolder is the folder downloads are saved to by default.
+	DownloadFolder string
+
+	MinWidth  int
+	MinHeight int
 	MaxWidth  int
 	MaxHeight int
+
+	// DisableResize prevents the user from resizing and maximising the window.
+	DisableResize bool
+	AlwaysOnTop   bool
+	// Centered moves the window to the center of its monitor unless a saved state is restored.
+	Centered bool
+	// StartState is the state the window is shown in unless a saved state is restored.
+	StartState WindowStartState
+	// StartHidden creates the window without showing it, it is shown in StartState by Show.
+	StartHidden bool
+	// BackgroundColour is shown until the page paints and behind transparent pages, it overrides the transparent
+	// background of Backdrop. The WebView is white if it is nil.
+	BackgroundColour *Colour
+
+	// StateKey identifies the window in StateStore, which restores its position, size and maximised or fullscreen
+	// state when it is created and saves them when it is closed. See ResetState.
+	StateKey   string
+	StateStore *windowstate.Store
+
+	// CredentialsProvider answers HTTP authentication challenges of pages. Deferred requests can be answered from
+	// other goroutines through Window.Invoke.
+	CredentialsProvider edge.CredentialsProvider
+
+	// CertificatePins are used to accept servers whose certificates can't be verified otherwise, e.g. because they
+	// have been issued by a private CA. See OnCertificateError.
+	CertificatePins pinning.Pins
+
+	// ClientCertificateThumbprint selects the client certificate with this SHA-1 thumbprint without asking the user
+	// when a server requests one. See OnClientCertificateRequested.
+	ClientCertificateThumbprint string
+
+	// TrustedFrameOrigins lists the origins, e.g. "https://example.com", of frames which may call bindings. The top
+	// level document is always trusted.
+	TrustedFrameOrigins []string
+
+	// ResourcePolicy suspends the page or reduces its memory usage while the window is inactive. See
+	// OnLifecycleChanged.
+	ResourcePolicy ResourcePolicy
+
+	// ExternalURIPolicy decides whether links with schemes like mailto: are opened and who asks the user. See
+	// OnExternalURI.
+	ExternalURIPolicy externaluri.Policy
+	// ExternalURIHandler receives the URIs for which the policy returned externaluri.Handle.
+	ExternalURIHandler func(uri string)
+
+	// PermissionRules answer the permission requests of pages, e.g. to allow the camera for a trusted origin.
+	PermissionRules permissions.Rules
+	// PermissionStore remembers the decisions of the user. See OnPermissionRequested.
+	PermissionStore *permissions.Store
+
+	// CustomSchemes are served by Go handlers, e.g. to load the frontend from app://.
+	CustomSchemes []CustomScheme
+
+	// EnvironmentOptions are applied when the browser environment is created. Windows sharing the data folder must
+	// use the same options. Their CustomSchemes are ignored, the schemes of CustomSchemes are registered instead.
+	EnvironmentOptions edge.EnvironmentOptions
+
+	// Debug marks a debug build of the application, RemoteDebugging is refused otherwise unless it is forced.
+	Debug bool
+	// RemoteDebugging lets tools like Playwright or Puppeteer control the WebView. See RemoteDebuggingURL.
+	RemoteDebugging *edge.RemoteDebugging
+
+	// Settings of the WebView, e.g. to disable the dev tools in release builds. The defaults of the runtime are
+	// kept if it is nil.
+	Settings *edge.Settings
 }
 
 type Window struct {
@@ -28,6 +124,43 @@ type Window struct {
 	opts     WindowOpts
 	chromium *edge.Chromium
 	handle   uintptr
+
+	onNavigationStarting  winc.EventManager
+	onContentLoading      winc.EventManager
+	onDOMReady            winc.EventManager
+	onNavigationCompleted winc.EventManager
+	onDownloadStarting    winc.EventManager
+	onContextMenu         winc.EventManager
+	onCertificateError    winc.EventManager
+
+	onClientCertificateRequested winc.EventManager
+	onFrameCreated               winc.EventManager
+	onLifecycleChanged           winc.EventManager
+	onExternalURI                winc.EventManager
+	onPermissionRequested        winc.EventManager
+	onProcessesChanged           winc.EventManager
+	onThemeChanged               winc.EventManager
+
+	messageHandlers map[string]func(*Frame, json.RawMessage)
+	downloads       map[uint64]*Download
+	frames          map[uint64]*Frame
+	bindings        map[string]reflect.Value
+	shortcuts       shortcut.Registry
+
+	lifecycle       LifecycleState
+	lifecycleInited bool
+
+	framelessResizable bool
+	framelessInited    bool
+	backdrop           Backdrop
+	theme              Theme
+	dark               bool
+
+	normalBounds windowstate.Bounds
+	stateReset   bool
+
+	startState      *WindowStartState
+	backgroundBrush *winc.Brush
 }
 
 func NewWindow(opts WindowOpts) *Window {
@@ -43,27 +176,70 @@ func NewWindow(opts WindowOpts) *Window {
 		chromium: chromium,
 		handle:   handle,
 		opts:     opts,
+		frames:   make(map[uint64]*Frame),
 	}
 
 	window.SetIsForm(true)
 	window.SetHandle(handle)
 	winc.RegMsgHandler(window)
 
-	win32.ShowWindow(handle)
-	w32.SetForegroundWindow(handle)
-	w32.SetFocus(handle)
-
-	window.SetSize(opts.InitialWidth, opts.InitialHeight)
+	window.initWindow()
 
 	chromium.AdditionalBrowserArgs = append(chromium.AdditionalBrowserArgs, "--enable-features=msWebView2EnableDraggableRegions")
 	chromium.MessageCallback = window.processMessage
 	chromium.WebResourceRequestedCallback = window.processRequest
+	chromium.NavigationStartingCallback = window.navigationStarting
+	chromium.ContentLoadingCallback = window.contentLoading
+	chromium.DOMContentLoadedCallback = window.domContentLoaded
 	chromium.NavigationCompletedCallback = window.navigationCompleted
+	chromium.DownloadStartingCallback = window.downloadStarting
+	chromium.ContextMenuRequestedCallback = window.contextMenuRequested
+	chromium.ServerCertificateErrorDetectedCallback = window.serverCertificateErrorDetected
+	chromium.ClientCertificateRequestedCallback = window.clientCertificateRequested
+	chromium.FrameCreatedCallback = window.frameCreated
+	chromium.LaunchingExternalUriSchemeCallback = window.launchingExternalUriScheme
+	chromium.PermissionRequestedCallback = window.permissionRequested
+	chromium.ProcessInfosChangedCallback = window.processInfosChanged
+	chromium.AcceleratorKeyCallback = window.acceleratorKeyPressed
+	chromium.CredentialsProvider = opts.CredentialsProvider
+	chromium.ProfileName = opts.ProfileName
+	chromium.InPrivate = opts.InPrivate
+	chromium.EnvironmentOptions = opts.EnvironmentOptions
+	chromium.Settings = opts.Settings
+	chromium.Debug = opts.Debug
+	chromium.RemoteDebugging = opts.RemoteDebugging
+	// Schemes are only registered together with their handler, the requests of the others would never be answered.
+	chromium.EnvironmentOptions.CustomSchemes = nil
+	if len(opts.EnvironmentOptions.CustomSchemes) > 0 {
+		log.Printf("Ignoring EnvironmentOptions.CustomSchemes without handlers, use WindowOpts.CustomSchemes instead")
+	}
+	for _, scheme := range opts.CustomSchemes {
+		chromium.EnvironmentOptions.CustomSchemes = append(chromium.EnvironmentOptions.CustomSchemes, edge.CustomScheme{
+			Name:                  scheme.Name,
+			TreatAsSecure:         scheme.TreatAsSecure,
+			AllowedOrigins:        scheme.AllowedOrigins,
+			HasAuthorityComponent: scheme.HasAuthorityComponent,
+		})
+	}
 
 	chromium.Embed(handle)
 	chromium.Resize()
+	chromium.Init(bridgeScript)
+	window.initDownloads()
+	window.initBindings()
+	window.initLifecycle()
+	if opts.Frameless {
+		window.initFrameless()
+	}
+	window.initDecorations()
+	window.initBackgroundColour()
+	window.initTheme()
+	if opts.DownloadFolder != "" {
+		if err := window.SetDownloadFolder(opts.DownloadFolder); err != nil {
+			log.Printf("Setting the download folder failed: %v", err)
+		}
+	}
 
-	chromium.SetGlobalPermission(edge.CoreWebView2PermissionStateAllow)
 	chromium.AddWebResourceRequestedFilter("*", edge.COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL)
 
 	if opts.InitialURL != "" {
@@ -91,6 +267,7 @@ func (w *Window) Run() {
 
 	w.OnClose().Bind(func(arg *winc.Event) {
 		if w.opts.MinimizeOnQuit {
+			w.closeState()
 			w.Hide()
 		} else {
 			w.Quit()
@@ -100,8 +277,12 @@ func (w *Window) Run() {
 	winc.RunMainLoop()
 }
 
+// Quit saves the placement of the window and exits the main loop.
 func (w *Window) Quit() {
-	w.Invoke(winc.Exit)
+	w.Invoke(func() {
+		w.closeState()
+		winc.Exit()
+	})
 }
 
 func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
@@ -109,16 +290,37 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	case win32.WM_POWERBROADCAST:
 		switch wparam {
 		case win32.PBT_APMSUSPEND:
-			log.Printf("[WndProc] SUSPEND")
+			w.setLifecycleState(LifecycleSystemSuspended)
 		case win32.PBT_APMRESUMEAUTOMATIC:
-			log.Printf("[WndProc] RESUME")
+			w.setLifecycleState(w.windowLifecycleState(win32.IsVisible(w.Handle())))
 		}
+	case w32.WM_SHOWWINDOW:
+		w.setLifecycleState(w.windowLifecycleState(wparam != 0))
+	case w32.WM_SIZE:
+		switch wparam {
+		case w32.SIZE_MINIMIZED:
+			w.setLifecycleState(LifecycleMinimized)
+		case w32.SIZE_RESTORED, w32.SIZE_MAXIMIZED:
+			if w.lifecycle == LifecycleMinimized {
+				w.setLifecycleState(LifecycleActive)
+			}
+		}
+		if w.opts.Frameless {
+			w.updateFramelessResizable()
+		}
+		w.trackNormalBounds()
 	case w32.WM_SETTINGCHANGE:
+		w.settingChanged(lparam)
 		return 0
+	case w32.WM_ERASEBKGND:
+		if w.eraseBackground(wparam) {
+			return 1
+		}
 	case w32.WM_NCLBUTTONDOWN:
 		w32.SetFocus(w.Handle())
 	case w32.WM_MOVE, w32.WM_MOVING:
 		w.chromium.NotifyParentWindowPositionChanged()
+		w.trackNormalBounds()
 	case 0x02E0: //w32.WM_DPICHANGED
 		newWindowSize := (*w32.RECT)(unsafe.Pointer(lparam))
 		w32.SetWindowPos(w.Handle(),
@@ -137,9 +339,13 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 			// This Option is not affected by returning 0 in WM_NCCALCSIZE.
 			// As a result we have hidden the titlebar but still have the default window frame styling.
 			// See: https://docs.microsoft.com/en-us/windows/win32/api/dwmapi/nf-dwmapi-dwmextendframeintoclientarea#remarks
-			// if w.framelessWithDecorations {
-			// 	win32.ExtendFrameIntoClientArea(w.Handle(), true)
-			// }
+			w.extendFrame()
+		case w32.WM_NCHITTEST:
+			// The WebView covers the client area, so this only sees the parts of the border it doesn't cover. The
+			// frameless script handles the border on top of the WebView.
+			if hit, ok := w.hitTest(lparam); ok {
+				return hit
+			}
 		case w32.WM_NCCALCSIZE:
 			// Disable the standard frame by allowing the client area to take the full
 			// window size.
@@ -204,65 +410,8 @@ func (w *Window) IsMaximised() bool {
 }
 
 func (w *Window) processMessage(message string) {
+	if w.dispatchInternalMessage(nil, message) {
+		return
+	}
 	log.Printf("processMessage(%v)", message)
 }
-
-func (w *Window) processRequest(req *edge.ICoreWebView2WebResourceRequest, args *edge.ICoreWebView2WebResourceRequestedEventArgs) {
-	return
-	// Setting the UserAgent on the CoreWebView2Settings clears the whole default UserAgent of the Edge browser, but
-	// we want to just append our ApplicationIdentifier. So we adjust the UserAgent for every request.
-	// if reqHeaders, err := req.GetHeaders(); err == nil {
-	// 	useragent, _ := reqHeaders.GetHeader(assetserver.HeaderUserAgent)
-	// 	useragent = strings.Join([]string{useragent, assetserver.WailsUserAgentValue}, " ")
-	// 	reqHeaders.SetHeader(assetserver.HeaderUserAgent, useragent)
-	// 	reqHeaders.Release()
-	// }
-
-	// if f.assets == nil {
-	// 	// We are using the devServer let the WebView2 handle the request with its default handler
-	// 	return
-	// }
-
-	// //Get the request
-	// uri, _ := req.GetUri()
-	// reqUri, err := url.ParseRequestURI(uri)
-	// if err != nil {
-	// 	f.logger.Error("Unable to parse equest uri %s: %s", uri, err)
-	// 	return
-	// }
-
-	// if reqUri.Scheme != f.startURL.Scheme {
-	// 	// Let the WebView2 handle the request with its default handler
-	// 	return
-	// } else if reqUri.Host != f.startURL.Host {
-	// 	// Let the WebView2 handle the request with its default handler
-	// 	return
-	// }
-
-	// rw := httptest.NewRecorder()
-	// f.assets.ProcessHTTPRequestLegacy(rw, coreWebview2RequestToHttpRequest(req))
-
-	// headers := []string{}
-	// for k, v := range rw.Header() {
-	// 	headers = append(headers, fmt.Sprintf("%s: %s", k, strings.Join(v, ",")))
-	// }
-
-	// env := f.chromium.Environment()
-	// response, err := env.CreateWebResourceResponse(rw.Body.Bytes(), rw.Code, http.StatusText(rw.Code), strings.Join(headers, "\n"))
-	// if err != nil {
-	// 	f.logger.Error("CreateWebResourceResponse Error: %s", err)
-	// 	return
-	// }
-	// defer response.Release()
-
-	// // Send response back
-	// err = args.PutResponse(response)
-	// if err != nil {
-	// 	f.logger.Error("PutResponse Error: %s", err)
-	// 	return
-	// }
-}
-
-func (w *Window) navigationCompleted(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationCompletedEventArgs) {
-	log.Printf("navigationCopleted(%v, %v)", sender, args)
-}
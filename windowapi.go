@@ -0,0 +1,217 @@
+package wv2
+
+import (
+	"log"
+
+	"github.com/b1naryth1ef/wv2/pkg/edge"
+	"github.com/b1naryth1ef/wv2/win32"
+	"github.com/b1naryth1ef/wv2/winc"
+	"github.com/b1naryth1ef/wv2/winc/w32"
+)
+
+type WindowStartState int
+
+const (
+	WindowNormal WindowStartState = iota
+	WindowMaximised
+	WindowMinimised
+	WindowFullscreen
+)
+
+// Colour of the window background, the WebView only supports an alpha of 0 or 255.
+type Colour struct {
+	R, G, B, A uint8
+}
+
+// initWindow applies the options of the window before the WebView is created and shows it.
+func (w *Window) initWindow() {
+	opts := w.opts
+	if opts.Title != "" {
+		w.SetTitle(opts.Title)
+	}
+	if opts.Icon != nil {
+		w.SetIcon(0, opts.Icon)
+		w.SetIcon(1, opts.Icon)
+	}
+	if opts.BackgroundColour != nil {
+		w.setBackgroundBrush(*opts.BackgroundColour)
+	}
+	w.Form.SetMinSize(opts.MinWidth, opts.MinHeight)
+	w.Form.SetMaxSize(opts.MaxWidth, opts.MaxHeight)
+	w.SetSize(opts.InitialWidth, opts.InitialHeight)
+	if opts.DisableResize {
+		w.SetResizable(false)
+	}
+	if opts.AlwaysOnTop {
+		w.SetAlwaysOnTop(true)
+	}
+
+	start, restored := w.initState()
+	if !restored {
+		start = opts.StartState
+		if opts.Centered {
+			w.Form.Center()
+		}
+	}
+	if opts.StartHidden {
+		w.startState = &start
+		return
+	}
+	w.showStartState(start)
+}
+
+// showStartState shows the window for the first time.
+func (w *Window) showStartState(start WindowStartState) {
+	switch start {
+	case WindowMaximised:
+		w.Form.Maximise()
+	case WindowMinimised:
+		w.Form.Minimise()
+		return
+	case WindowFullscreen:
+		win32.ShowWindow(w.Handle())
+		w.Form.Fullscreen()
+	default:
+		win32.ShowWindow(w.Handle())
+	}
+	w32.SetForegroundWindow(w.Handle())
+	w32.SetFocus(w.Handle())
+}
+
+// Show shows the window. Windows created with StartHidden get their start state or the restored state when they
+// are shown the first time.
+func (w *Window) Show() {
+	if start := w.startState; start != nil {
+		w.startState = nil
+		w.showStartState(*start)
+		return
+	}
+	w.Form.Show()
+}
+
+// SetTitle changes the title of the window shown in the title bar and the taskbar.
+func (w *Window) SetTitle(title string) {
+	w.opts.Title = title
+	w.SetText(title)
+}
+
+// SetMinSize changes the minimum size of the window in DIP, 0 removes the limit. The window grows if it is too
+// small.
+func (w *Window) SetMinSize(width, height int) {
+	w.opts.MinWidth, w.opts.MinHeight = width, height
+	w.Form.SetMinSize(width, height)
+	w.chromium.Resize()
+}
+
+// SetMaxSize changes the maximum size of the window in DIP, 0 removes the limit. The window shrinks if it is too
+// large, maximised frameless windows are limited too.
+func (w *Window) SetMaxSize(width, height int) {
+	w.opts.MaxWidth, w.opts.MaxHeight = width, height
+	w.Form.SetMaxSize(width, height)
+	w.chromium.Resize()
+}
+
+// SetResizable allows or prevents the user from resizing and maximising the window. Frameless windows keep their
+// thick frame and disable their resize border instead, without the maximise box Win+Up doesn't maximise them.
+func (w *Window) SetResizable(resizable bool) {
+	w.opts.DisableResize = !resizable
+	w.EnableMaxButton(resizable)
+	if w.opts.Frameless {
+		w.updateFramelessResizable()
+		return
+	}
+
+	w.EnableSizable(resizable)
+	// Style changes only apply to the frame once it is recalculated.
+	w32.SetWindowPos(w.Handle(), 0, 0, 0, 0, 0,
+		w32.SWP_NOMOVE|w32.SWP_NOSIZE|w32.SWP_NOZORDER|w32.SWP_NOACTIVATE|w32.SWP_FRAMECHANGED)
+	w.chromium.Resize()
+}
+
+// Resizable reports whether the user may resize the window.
+func (w *Window) Resizable() bool {
+	return !w.opts.DisableResize
+}
+
+// SetAlwaysOnTop keeps the window above all windows which aren't topmost.
+func (w *Window) SetAlwaysOnTop(alwaysOnTop bool) {
+	w.opts.AlwaysOnTop = alwaysOnTop
+	w.Form.SetAlwaysOnTop(alwaysOnTop)
+}
+
+// Center moves the window to the center of the work area of its monitor, maximised and fullscreen windows aren't
+// moved.
+func (w *Window) Center() {
+	if w.IsMaximised() || w.IsFullScreen() {
+		return
+	}
+	w.Form.Center()
+}
+
+// Maximise maximises the window, leaving fullscreen first.
+func (w *Window) Maximise() {
+	if w.IsFullScreen() {
+		w.UnFullscreen()
+	}
+	w.Form.Maximise()
+}
+
+// Fullscreen covers the whole monitor with the WebView.
+func (w *Window) Fullscreen() {
+	w.Form.Fullscreen()
+	w.fullscreenChanged()
+}
+
+// UnFullscreen restores the window to the placement it had before Fullscreen.
+func (w *Window) UnFullscreen() {
+	w.Form.UnFullscreen()
+	w.fullscreenChanged()
+}
+
+// ToggleFullscreen switches between fullscreen and the previous placement of the window.
+func (w *Window) ToggleFullscreen() {
+	if w.IsFullScreen() {
+		w.UnFullscreen()
+	} else {
+		w.Fullscreen()
+	}
+}
+
+// fullscreenChanged syncs the padding of frameless windows and the bounds of the WebView, which don't get a
+// WM_SIZE if the size of the window didn't change.
+func (w *Window) fullscreenChanged() {
+	if w.opts.Frameless {
+		if w.IsFullScreen() {
+			w.chromium.SetPadding(edge.Rect{})
+		}
+		w.updateFramelessResizable()
+	}
+	w.chromium.Resize()
+}
+
+// SetBackgroundColour changes the colour behind the page which is shown until the page paints and wherever it is
+// transparent. An alpha of 0 makes the WebView transparent, which only shows something with a Backdrop.
+func (w *Window) SetBackgroundColour(colour Colour) error {
+	w.opts.BackgroundColour = &colour
+	w.setBackgroundBrush(colour)
+	w.Invalidate(true)
+	return w.chromium.PutDefaultBackgroundColor(edge.COREWEBVIEW2_COLOR{A: colour.A, R: colour.R, G: colour.G, B: colour.B})
+}
+
+// initBackgroundColour applies WindowOpts.BackgroundColour to the WebView after it has been created.
+func (w *Window) initBackgroundColour() {
+	if c := w.opts.BackgroundColour; c != nil {
+		if err := w.SetBackgroundColour(*c); err != nil {
+			log.Printf("Setting the background colour failed: %v", err)
+		}
+	}
+}
+
+// setBackgroundBrush changes the colour the window erases its background with, the class background is shared by
+// all windows.
+func (w *Window) setBackgroundBrush(colour Colour) {
+	if w.backgroundBrush != nil {
+		w.backgroundBrush.Dispose()
+	}
+	w.backgroundBrush = winc.NewSolidColorBrush(winc.RGB(colour.R, colour.G, colour.B))
+}
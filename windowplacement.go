@@ -0,0 +1,183 @@
+package wv2
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/b1naryth1ef/wv2/pkg/windowstate"
+	"github.com/b1naryth1ef/wv2/win32"
+	"github.com/b1naryth1ef/wv2/winc/w32"
+)
+
+// errNoStateStore is returned by the state methods if WindowOpts.StateKey or StateStore is missing.
+var errNoStateStore = errors.New("wv2: the window has no StateKey and StateStore")
+
+var (
+	enumMonitorsMu       sync.Mutex
+	enumMonitorsResult   []windowstate.Monitor
+	enumMonitorsCallback = syscall.NewCallback(func(monitor, hdc, rect, data uintptr) uintptr {
+		if info, ok := monitorInfo(w32.HMONITOR(monitor)); ok {
+			area := windowstate.Monitor{Name: info.name, Work: info.work}
+			if info.primary {
+				enumMonitorsResult = append([]windowstate.Monitor{area}, enumMonitorsResult...)
+			} else {
+				enumMonitorsResult = append(enumMonitorsResult, area)
+			}
+		}
+		return 1
+	})
+)
+
+type monitorDetails struct {
+	name    string
+	work    windowstate.Bounds
+	primary bool
+}
+
+func monitorInfo(monitor w32.HMONITOR) (monitorDetails, bool) {
+	var info w32.MONITORINFOEX
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	if monitor == 0 || !w32.GetMonitorInfo(monitor, &info.MONITORINFO) {
+		return monitorDetails{}, false
+	}
+	return monitorDetails{
+		name:    syscall.UTF16ToString(info.SzDevice[:]),
+		work:    rectBounds(info.RcWork),
+		primary: info.DwFlags&w32.MONITORINFOF_PRIMARY != 0,
+	}, true
+}
+
+// monitors returns the connected monitors, the primary one first.
+func monitors() []windowstate.Monitor {
+	enumMonitorsMu.Lock()
+	defer enumMonitorsMu.Unlock()
+
+	enumMonitorsResult = nil
+	w32.EnumDisplayMonitors(0, nil, enumMonitorsCallback, nil)
+	return enumMonitorsResult
+}
+
+func rectBounds(r w32.RECT) windowstate.Bounds {
+	return windowstate.Bounds{X: int(r.Left), Y: int(r.Top), Width: int(r.Right - r.Left), Height: int(r.Bottom - r.Top)}
+}
+
+// trackNormalBounds remembers the bounds of the window while it is neither minimised, maximised nor fullscreen,
+// those are saved and restored.
+func (w *Window) trackNormalBounds() {
+	if w.IsMaximised() || w.IsFullScreen() || win32.IsWindowMinimised(w.Handle()) {
+		return
+	}
+	w.normalBounds = rectBounds(*w32.GetWindowRect(w.Handle()))
+}
+
+// State returns the current placement of the window.
+func (w *Window) State() windowstate.State {
+	w.trackNormalBounds()
+	state := windowstate.State{
+		Bounds:     w.normalBounds,
+		Maximised:  w.IsMaximised(),
+		Fullscreen: w.IsFullScreen(),
+	}
+
+	// The monitor of the normal bounds, a fullscreen window might be on another one.
+	rect := w32.RECT{
+		Left:   int32(state.Bounds.X),
+		Top:    int32(state.Bounds.Y),
+		Right:  int32(state.Bounds.X + state.Bounds.Width),
+		Bottom: int32(state.Bounds.Y + state.Bounds.Height),
+	}
+	if info, ok := monitorInfo(w32.MonitorFromRect(&rect, w32.MONITOR_DEFAULTTONEAREST)); ok {
+		state.Monitor = info.name
+		state.MonitorWork = info.work
+	}
+	return state
+}
+
+// RestoreState moves the window to the saved state, adjusted to the connected monitors.
+func (w *Window) RestoreState(state windowstate.State) {
+	state, ok := w.restoreBounds(state)
+	if !ok {
+		return
+	}
+	switch {
+	case state.Fullscreen:
+		w.Fullscreen()
+	case state.Maximised:
+		w.Maximise()
+	}
+}
+
+// restoreBounds moves the window to the normal bounds of the state and returns the adjusted state.
+func (w *Window) restoreBounds(state windowstate.State) (windowstate.State, bool) {
+	state, ok := windowstate.Clamp(state, monitors())
+	if !ok {
+		return state, false
+	}
+
+	if w.IsFullScreen() {
+		w.UnFullscreen()
+	}
+	if w.IsMaximised() {
+		w.Restore()
+	}
+	w32.SetWindowPos(w.Handle(), 0, state.Bounds.X, state.Bounds.Y, state.Bounds.Width, state.Bounds.Height,
+		w32.SWP_NOZORDER|w32.SWP_NOACTIVATE)
+	w.normalBounds = state.Bounds
+	return state, true
+}
+
+// SaveState saves the placement of the window in WindowOpts.StateStore, which happens automatically when the
+// window is closed.
+func (w *Window) SaveState() error {
+	if w.opts.StateKey == "" || w.opts.StateStore == nil {
+		return errNoStateStore
+	}
+	w.stateReset = false
+	return w.opts.StateStore.Set(w.opts.StateKey, w.State())
+}
+
+// ResetState removes the saved placement, so the window opens with the size of WindowOpts the next time. The state
+// isn't saved when the window is closed, unless SaveState is called again.
+func (w *Window) ResetState() error {
+	if w.opts.StateKey == "" || w.opts.StateStore == nil {
+		return errNoStateStore
+	}
+	w.stateReset = true
+	return w.opts.StateStore.Reset(w.opts.StateKey)
+}
+
+// initState restores the saved bounds before the window is shown and returns the state to show it in.
+func (w *Window) initState() (WindowStartState, bool) {
+	w.trackNormalBounds()
+	if w.opts.StateKey == "" || w.opts.StateStore == nil {
+		return WindowNormal, false
+	}
+	state, ok := w.opts.StateStore.Get(w.opts.StateKey)
+	if !ok {
+		return WindowNormal, false
+	}
+	if state, ok = w.restoreBounds(state); !ok {
+		return WindowNormal, false
+	}
+
+	switch {
+	case state.Fullscreen:
+		return WindowFullscreen, true
+	case state.Maximised:
+		return WindowMaximised, true
+	}
+	return WindowNormal, true
+}
+
+// closeState saves the placement when the window is closed or the application quits.
+func (w *Window) closeState() {
+	if w.opts.StateKey == "" || w.opts.StateStore == nil || w.stateReset {
+		return
+	}
+	if err := w.SaveState(); err != nil {
+		log.Printf("Saving the window state failed: %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package wv2test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// FakeCall is a DevTools protocol call received by a FakeBackend.
+type FakeCall struct {
+	Method string
+	Params json.RawMessage
+}
+
+// FakeBackend answers DevTools protocol calls with handlers registered by the test, so code built on the Harness
+// can be tested without a browser. Calls without a handler fail.
+type FakeBackend struct {
+	mu       sync.Mutex
+	handlers map[string]func(params json.RawMessage) (interface{}, error)
+	calls    []FakeCall
+	closed   bool
+}
+
+func NewFakeBackend() *FakeBackend {
+	return &FakeBackend{handlers: make(map[string]func(json.RawMessage) (interface{}, error))}
+}
+
+// Handle answers calls of method with the result of fn encoded as JSON.
+func (f *FakeBackend) Handle(method string, fn func(params json.RawMessage) (interface{}, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[method] = fn
+}
+
+// HandleEval answers Runtime.evaluate with the value returned by fn, errors are reported as exceptions of the page.
+func (f *FakeBackend) HandleEval(fn func(expression string) (interface{}, error)) {
+	f.Handle("Runtime.evaluate", func(params json.RawMessage) (interface{}, error) {
+		var p struct {
+			Expression string `json:"expression"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+
+		value, err := fn(p.Expression)
+		if err != nil {
+			return map[string]interface{}{
+				"result":           map[string]string{"type": "object"},
+				"exceptionDetails": map[string]interface{}{"text": "Uncaught", "exception": map[string]string{"description": err.Error()}},
+			}, nil
+		}
+		if value == nil {
+			return map[string]interface{}{"result": map[string]string{"type": "undefined"}}, nil
+		}
+		return map[string]interface{}{"result": map[string]interface{}{"type": "object", "value": value}}, nil
+	})
+}
+
+// Calls returns the calls received so far.
+func (f *FakeBackend) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Closed reports whether the harness closed the backend.
+func (f *FakeBackend) Closed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.closed
+}
+
+func (f *FakeBackend) CallDevToolsProtocolMethod(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	if f.closed {
+		f.mu.Unlock()
+		return nil, fmt.Errorf("wv2test: call of %s after the backend has been closed", method)
+	}
+	f.calls = append(f.calls, FakeCall{Method: method, Params: data})
+	fn := f.handlers[method]
+	f.mu.Unlock()
+
+	if fn == nil {
+		return nil, fmt.Errorf("wv2test: no handler for %s", method)
+	}
+	result, err := fn(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+func (f *FakeBackend) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
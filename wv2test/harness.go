@@ -0,0 +1,435 @@
+// Package wv2test drives the WebView of a wv2 application through the DevTools protocol for end-to-end tests.
+//
+// The helpers of a Harness fail the test if they don't succeed within the timeout, screenshots and the DOM of the
+// page are saved when a test fails. The harness only depends on a Backend, NewWindow provides one for a real
+// window and FakeBackend one for testing code built on the harness without a browser.
+package wv2test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Backend sends DevTools protocol calls to the page under test.
+type Backend interface {
+	// CallDevToolsProtocolMethod calls method with params encoded as JSON and returns the result object.
+	CallDevToolsProtocolMethod(ctx context.Context, method string, params interface{}) (json.RawMessage, error)
+	// Close is called when the test finished.
+	Close() error
+}
+
+type Options struct {
+	// Timeout of each helper, it defaults to 10 seconds and is shortened to end before the deadline of the test.
+	Timeout time.Duration
+	// PollInterval of the helpers waiting for the page, it defaults to 50 milliseconds.
+	PollInterval time.Duration
+	// ArtifactDir receives a folder with a screenshot and the DOM of the page for every failed test. It defaults to
+	// $WV2TEST_ARTIFACTS or wv2test in the temporary directory.
+	ArtifactDir string
+}
+
+// Harness offers helpers to drive the page of a Backend from a test.
+type Harness struct {
+	t       testing.TB
+	backend Backend
+	opts    Options
+}
+
+// New returns a harness for backend, which is closed when the test finished.
+func New(t testing.TB, backend Backend, opts Options) *Harness {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 50 * time.Millisecond
+	}
+	if opts.ArtifactDir == "" {
+		opts.ArtifactDir = os.Getenv("WV2TEST_ARTIFACTS")
+	}
+	if opts.ArtifactDir == "" {
+		opts.ArtifactDir = filepath.Join(os.TempDir(), "wv2test")
+	}
+
+	h := &Harness{t: t, backend: backend, opts: opts}
+	t.Cleanup(func() {
+		if t.Failed() {
+			h.captureArtifacts()
+		}
+		if err := backend.Close(); err != nil {
+			t.Logf("wv2test: closing the backend failed: %v", err)
+		}
+	})
+	return h
+}
+
+// context returns a context with the timeout of a helper. The timeout ends a bit before the deadline of the test,
+// so the failure is reported by the helper and artifacts can be captured.
+func (h *Harness) context() (context.Context, context.CancelFunc) {
+	timeout := h.opts.Timeout
+	if t, ok := h.t.(interface{ Deadline() (time.Time, bool) }); ok {
+		if deadline, ok := t.Deadline(); ok {
+			if remaining := time.Until(deadline) - 2*time.Second; remaining < timeout {
+				timeout = remaining
+			}
+		}
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// Call calls a method of the DevTools protocol and returns its result object.
+func (h *Harness) Call(method string, params interface{}) json.RawMessage {
+	h.t.Helper()
+	ctx, cancel := h.context()
+	defer cancel()
+
+	result, err := h.backend.CallDevToolsProtocolMethod(ctx, method, params)
+	if err != nil {
+		h.t.Fatalf("wv2test: %s: %v", method, err)
+	}
+	return result
+}
+
+// Navigate loads url and waits until the new document has been loaded completely.
+func (h *Harness) Navigate(url string) {
+	h.t.Helper()
+	ctx, cancel := h.context()
+	defer cancel()
+
+	// The marker tells the old document apart from the new one.
+	if _, err := h.eval(ctx, "window.__wv2testUnloaded = true"); err != nil {
+		h.t.Fatalf("wv2test: navigating to %s: %v", url, err)
+	}
+	result, err := h.backend.CallDevToolsProtocolMethod(ctx, "Page.navigate", map[string]string{"url": url})
+	if err != nil {
+		h.t.Fatalf("wv2test: navigating to %s: %v", url, err)
+	}
+	var navigation struct {
+		ErrorText string `json:"errorText"`
+	}
+	if err := json.Unmarshal(result, &navigation); err == nil && navigation.ErrorText != "" {
+		h.t.Fatalf("wv2test: navigating to %s: %s", url, navigation.ErrorText)
+	}
+
+	if err := h.waitFor(ctx, `window.__wv2testUnloaded === undefined && document.readyState === "complete"`); err != nil {
+		h.t.Fatalf("wv2test: waiting for %s to load: %v", url, err)
+	}
+}
+
+// Eval evaluates expression in the page and returns its value as JSON. Promises are awaited, exceptions fail the
+// test.
+func (h *Harness) Eval(expression string) json.RawMessage {
+	h.t.Helper()
+	ctx, cancel := h.context()
+	defer cancel()
+
+	value, err := h.eval(ctx, expression)
+	if err != nil {
+		h.t.Fatalf("wv2test: evaluating %s: %v", expression, err)
+	}
+	return value
+}
+
+// EvalInto is like Eval but unmarshals the value into v.
+func (h *Harness) EvalInto(expression string, v interface{}) {
+	h.t.Helper()
+	if err := json.Unmarshal(h.Eval(expression), v); err != nil {
+		h.t.Fatalf("wv2test: evaluating %s: %v", expression, err)
+	}
+}
+
+// WaitFor waits until expression evaluates to a truthy value.
+func (h *Harness) WaitFor(expression string) {
+	h.t.Helper()
+	ctx, cancel := h.context()
+	defer cancel()
+
+	if err := h.waitFor(ctx, expression); err != nil {
+		h.t.Fatalf("wv2test: waiting for %s: %v", expression, err)
+	}
+}
+
+// WaitForSelector waits until an element matches the CSS selector.
+func (h *Harness) WaitForSelector(selector string) {
+	h.t.Helper()
+	ctx, cancel := h.context()
+	defer cancel()
+
+	if err := h.waitFor(ctx, "document.querySelector("+quote(selector)+") !== null"); err != nil {
+		h.t.Fatalf("wv2test: waiting for %s: %v", selector, err)
+	}
+}
+
+// Click clicks the center of the first element matching selector with the left mouse button.
+func (h *Harness) Click(selector string) {
+	h.t.Helper()
+	h.WaitForSelector(selector)
+	ctx, cancel := h.context()
+	defer cancel()
+
+	var center struct{ X, Y float64 }
+	value, err := h.eval(ctx, `(() => {
+		const el = document.querySelector(`+quote(selector)+`);
+		el.scrollIntoView({block: "center", inline: "center"});
+		const rect = el.getBoundingClientRect();
+		return {x: rect.left + rect.width / 2, y: rect.top + rect.height / 2};
+	})()`)
+	if err == nil {
+		err = json.Unmarshal(value, &center)
+	}
+	if err != nil {
+		h.t.Fatalf("wv2test: clicking %s: %v", selector, err)
+	}
+
+	for _, typ := range []string{"mouseMoved", "mousePressed", "mouseReleased"} {
+		params := map[string]interface{}{"type": typ, "x": center.X, "y": center.Y}
+		if typ != "mouseMoved" {
+			params["button"] = "left"
+			params["clickCount"] = 1
+		}
+		if _, err := h.backend.CallDevToolsProtocolMethod(ctx, "Input.dispatchMouseEvent", params); err != nil {
+			h.t.Fatalf("wv2test: clicking %s: %v", selector, err)
+		}
+	}
+}
+
+// Type focuses the first element matching selector and inserts text as if it had been typed. Only input events
+// are fired, no key events.
+func (h *Harness) Type(selector, text string) {
+	h.t.Helper()
+	h.WaitForSelector(selector)
+	ctx, cancel := h.context()
+	defer cancel()
+
+	if _, err := h.eval(ctx, "document.querySelector("+quote(selector)+").focus()"); err != nil {
+		h.t.Fatalf("wv2test: typing into %s: %v", selector, err)
+	}
+	if _, err := h.backend.CallDevToolsProtocolMethod(ctx, "Input.insertText", map[string]string{"text": text}); err != nil {
+		h.t.Fatalf("wv2test: typing into %s: %v", selector, err)
+	}
+}
+
+// Screenshot returns a PNG of the visible part of the page.
+func (h *Harness) Screenshot() []byte {
+	h.t.Helper()
+	ctx, cancel := h.context()
+	defer cancel()
+
+	png, err := h.screenshot(ctx)
+	if err != nil {
+		h.t.Fatalf("wv2test: taking a screenshot: %v", err)
+	}
+	return png
+}
+
+// ExpectBinding records the calls of the binding name made through window.wv2.bindings from now on, until the
+// page navigates. The calls are still passed on to the Go func of the binding.
+func (h *Harness) ExpectBinding(name string) *BindingExpectation {
+	h.t.Helper()
+	ctx, cancel := h.context()
+	defer cancel()
+
+	if err := h.waitFor(ctx, "window.wv2 && window.wv2.bindings && window.wv2.bindings["+quote(name)+"]"); err != nil {
+		h.t.Fatalf("wv2test: waiting for binding %s: %v", name, err)
+	}
+	_, err := h.eval(ctx, `(() => {
+		const name = `+quote(name)+`;
+		const state = window.__wv2test || (window.__wv2test = {calls: {}});
+		state.calls[name] = [];
+		const fn = window.wv2.bindings[name];
+		if (!fn.__wv2test) {
+			const wrapped = (...args) => {
+				state.calls[name].push(args);
+				return fn(...args);
+			};
+			wrapped.__wv2test = true;
+			window.wv2.bindings[name] = wrapped;
+		}
+	})()`)
+	if err != nil {
+		h.t.Fatalf("wv2test: expecting binding %s: %v", name, err)
+	}
+	return &BindingExpectation{h: h, name: name}
+}
+
+// BindingExpectation waits for the calls recorded by ExpectBinding.
+type BindingExpectation struct {
+	h    *Harness
+	name string
+	seen int
+}
+
+// Wait waits for the next call of the binding and returns its arguments as JSON.
+func (e *BindingExpectation) Wait() []json.RawMessage {
+	e.h.t.Helper()
+	ctx, cancel := e.h.context()
+	defer cancel()
+
+	calls := "window.__wv2test.calls[" + quote(e.name) + "]"
+	if err := e.h.waitFor(ctx, fmt.Sprintf("%s.length > %d", calls, e.seen)); err != nil {
+		e.h.t.Fatalf("wv2test: waiting for a call of binding %s: %v", e.name, err)
+	}
+	value, err := e.h.eval(ctx, fmt.Sprintf("%s[%d]", calls, e.seen))
+	var args []json.RawMessage
+	if err == nil {
+		err = json.Unmarshal(value, &args)
+	}
+	if err != nil {
+		e.h.t.Fatalf("wv2test: reading the call of binding %s: %v", e.name, err)
+	}
+	e.seen++
+	return args
+}
+
+// WaitArgs waits for the next call of the binding and unmarshals its arguments into the pointers of args.
+func (e *BindingExpectation) WaitArgs(args ...interface{}) {
+	e.h.t.Helper()
+	got := e.Wait()
+	if len(got) != len(args) {
+		e.h.t.Fatalf("wv2test: binding %s has been called with %d arguments, expected %d", e.name, len(got), len(args))
+	}
+	for i, arg := range args {
+		if err := json.Unmarshal(got[i], arg); err != nil {
+			e.h.t.Fatalf("wv2test: argument %d of binding %s: %v", i, e.name, err)
+		}
+	}
+}
+
+// evalResult is the result object of Runtime.evaluate.
+type evalResult struct {
+	Result struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	} `json:"result"`
+	ExceptionDetails *struct {
+		Text      string `json:"text"`
+		Exception *struct {
+			Description string `json:"description"`
+		} `json:"exception"`
+	} `json:"exceptionDetails"`
+}
+
+func (h *Harness) eval(ctx context.Context, expression string) (json.RawMessage, error) {
+	data, err := h.backend.CallDevToolsProtocolMethod(ctx, "Runtime.evaluate", map[string]interface{}{
+		"expression":    expression,
+		"returnByValue": true,
+		"awaitPromise":  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result evalResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	if d := result.ExceptionDetails; d != nil {
+		if d.Exception != nil && d.Exception.Description != "" {
+			return nil, errors.New(d.Exception.Description)
+		}
+		return nil, errors.New(d.Text)
+	}
+	if len(result.Result.Value) == 0 {
+		// undefined has no value
+		return json.RawMessage("null"), nil
+	}
+	return result.Result.Value, nil
+}
+
+// waitFor polls expression until it is truthy, exceptions are treated as falsy since the page might still be
+// loading.
+func (h *Harness) waitFor(ctx context.Context, expression string) error {
+	expression = "!!(" + expression + ")"
+	ticker := time.NewTicker(h.opts.PollInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		value, err := h.eval(ctx, expression)
+		if err == nil && string(value) == "true" {
+			return nil
+		}
+		if err != nil && ctx.Err() == nil {
+			// The last call fails with the context, which would hide the error of the page.
+			lastErr = err
+		}
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return fmt.Errorf("%w, last error: %v", ctx.Err(), lastErr)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Harness) screenshot(ctx context.Context) ([]byte, error) {
+	data, err := h.backend.CallDevToolsProtocolMethod(ctx, "Page.captureScreenshot", map[string]string{"format": "png"})
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Data string `json:"data"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(result.Data)
+}
+
+// captureArtifacts saves a screenshot and the DOM of the page after the test failed.
+func (h *Harness) captureArtifacts() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dir := filepath.Join(h.opts.ArtifactDir, artifactName(h.t.Name()))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		h.t.Logf("wv2test: saving artifacts failed: %v", err)
+		return
+	}
+
+	if png, err := h.screenshot(ctx); err != nil {
+		h.t.Logf("wv2test: taking a screenshot failed: %v", err)
+	} else if err := os.WriteFile(filepath.Join(dir, "screenshot.png"), png, 0o644); err != nil {
+		h.t.Logf("wv2test: saving the screenshot failed: %v", err)
+	}
+
+	var html string
+	value, err := h.eval(ctx, `location.href + "\n" + document.documentElement.outerHTML`)
+	if err == nil {
+		err = json.Unmarshal(value, &html)
+	}
+	if err != nil {
+		h.t.Logf("wv2test: reading the DOM failed: %v", err)
+	} else if err := os.WriteFile(filepath.Join(dir, "dom.html"), []byte(html), 0o644); err != nil {
+		h.t.Logf("wv2test: saving the DOM failed: %v", err)
+	}
+
+	h.t.Logf("wv2test: artifacts saved to %s", dir)
+}
+
+// artifactName turns the name of a (sub)test into a folder name.
+func artifactName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ':
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+// quote returns s as JavaScript string literal.
+func quote(s string) string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
@@ -0,0 +1,504 @@
+package wv2test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeT records the failures of the harness instead of failing the test running it.
+type fakeT struct {
+	testing.TB
+	name string
+
+	mu       sync.Mutex
+	failed   bool
+	errors   []string
+	logs     []string
+	cleanups []func()
+}
+
+func newFakeT(t *testing.T, name string) *fakeT {
+	return &fakeT{TB: t, name: name}
+}
+
+func (f *fakeT) Helper()      {}
+func (f *fakeT) Name() string { return f.name }
+
+func (f *fakeT) Failed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.failed
+}
+
+func (f *fakeT) Fatalf(format string, args ...interface{}) {
+	f.mu.Lock()
+	f.failed = true
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+	f.mu.Unlock()
+	runtime.Goexit()
+}
+
+func (f *fakeT) Logf(format string, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.logs = append(f.logs, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Cleanup(fn func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cleanups = append(f.cleanups, fn)
+}
+
+// run calls fn like the body of a test, Fatalf ends it.
+func (f *fakeT) run(fn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	<-done
+}
+
+// finish runs the cleanups like at the end of a test.
+func (f *fakeT) finish() {
+	for i := len(f.cleanups) - 1; i >= 0; i-- {
+		f.cleanups[i]()
+	}
+}
+
+// wantFailure checks that fn failed with a message containing all of parts.
+func (f *fakeT) wantFailure(t *testing.T, fn func(), parts ...string) {
+	t.Helper()
+	f.run(fn)
+	if !f.Failed() {
+		t.Fatal("the harness didn't fail the test")
+	}
+	msg := f.errors[len(f.errors)-1]
+	for _, part := range parts {
+		if !strings.Contains(msg, part) {
+			t.Errorf("failure %q doesn't contain %q", msg, part)
+		}
+	}
+}
+
+var fastOptions = Options{Timeout: 200 * time.Millisecond, PollInterval: 5 * time.Millisecond}
+
+func newTestHarness(t *testing.T, opts Options) (*Harness, *FakeBackend, *fakeT) {
+	ft := newFakeT(t, t.Name())
+	backend := NewFakeBackend()
+	if opts.ArtifactDir == "" {
+		opts.ArtifactDir = t.TempDir()
+	}
+	h := New(ft, backend, opts)
+	t.Cleanup(ft.finish)
+	return h, backend, ft
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"object", map[string]int{"a": 1}, `{"a":1}`},
+		{"string", "text", `"text"`},
+		{"undefined", nil, "null"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, backend, ft := newTestHarness(t, fastOptions)
+			backend.HandleEval(func(string) (interface{}, error) { return tt.value, nil })
+
+			var got json.RawMessage
+			ft.run(func() { got = h.Eval("value") })
+			if ft.Failed() {
+				t.Fatalf("Eval failed: %v", ft.errors)
+			}
+			if string(got) != tt.want {
+				t.Fatalf("Eval() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalParams(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	backend.HandleEval(func(string) (interface{}, error) { return true, nil })
+	ft.run(func() { h.Eval("1 + 1") })
+
+	calls := backend.Calls()
+	if len(calls) != 1 || calls[0].Method != "Runtime.evaluate" {
+		t.Fatalf("calls = %+v", calls)
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal(calls[0].Params, &params); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"expression": "1 + 1", "returnByValue": true, "awaitPromise": true}
+	if !reflect.DeepEqual(params, want) {
+		t.Fatalf("params = %v, want %v", params, want)
+	}
+}
+
+func TestEvalException(t *testing.T) {
+	t.Run("description", func(t *testing.T) {
+		h, backend, ft := newTestHarness(t, fastOptions)
+		backend.HandleEval(func(string) (interface{}, error) {
+			return nil, errors.New("ReferenceError: missing is not defined")
+		})
+		ft.wantFailure(t, func() { h.Eval("missing") }, "evaluating missing", "ReferenceError: missing is not defined")
+	})
+
+	t.Run("text", func(t *testing.T) {
+		h, backend, ft := newTestHarness(t, fastOptions)
+		backend.Handle("Runtime.evaluate", func(json.RawMessage) (interface{}, error) {
+			return map[string]interface{}{
+				"result":           map[string]string{"type": "object"},
+				"exceptionDetails": map[string]string{"text": "Uncaught (in promise)"},
+			}, nil
+		})
+		ft.wantFailure(t, func() { h.Eval("Promise.reject()") }, "Uncaught (in promise)")
+	})
+
+	t.Run("backend error", func(t *testing.T) {
+		h, _, ft := newTestHarness(t, fastOptions)
+		ft.wantFailure(t, func() { h.Eval("1") }, "no handler for Runtime.evaluate")
+	})
+}
+
+func TestEvalInto(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	backend.HandleEval(func(string) (interface{}, error) { return []string{"a", "b"}, nil })
+
+	var got []string
+	ft.run(func() { h.EvalInto("list", &got) })
+	if ft.Failed() || !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Fatalf("EvalInto() = %v, errors %v", got, ft.errors)
+	}
+
+	var wrong int
+	ft.wantFailure(t, func() { h.EvalInto("list", &wrong) }, "evaluating list")
+}
+
+func TestWaitFor(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	var mu sync.Mutex
+	var expressions []string
+	backend.HandleEval(func(expression string) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		expressions = append(expressions, expression)
+		return len(expressions) >= 3, nil
+	})
+
+	ft.run(func() { h.WaitFor("ready") })
+	if ft.Failed() {
+		t.Fatalf("WaitFor failed: %v", ft.errors)
+	}
+	if len(expressions) != 3 {
+		t.Fatalf("evaluated %d times, want 3", len(expressions))
+	}
+	if expressions[0] != "!!(ready)" {
+		t.Fatalf("expression = %q, want !!(ready)", expressions[0])
+	}
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	t.Run("last error", func(t *testing.T) {
+		h, backend, ft := newTestHarness(t, fastOptions)
+		n := 0
+		backend.HandleEval(func(string) (interface{}, error) {
+			n++
+			if n == 1 {
+				return nil, errors.New("TypeError: app is undefined")
+			}
+			return false, nil
+		})
+		ft.wantFailure(t, func() { h.WaitFor("app.ready") },
+			"waiting for app.ready", "context deadline exceeded", "last error: TypeError: app is undefined")
+	})
+
+	t.Run("falsy", func(t *testing.T) {
+		h, backend, ft := newTestHarness(t, fastOptions)
+		backend.HandleEval(func(string) (interface{}, error) { return false, nil })
+		ft.wantFailure(t, func() { h.WaitFor("app.ready") }, "context deadline exceeded")
+		if msg := ft.errors[0]; strings.Contains(msg, "last error") {
+			t.Fatalf("failure %q reports an error although there was none", msg)
+		}
+	})
+}
+
+func TestNavigate(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	var mu sync.Mutex
+	unloaded, loaded := false, false
+	backend.HandleEval(func(expression string) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if expression == "window.__wv2testUnloaded = true" {
+			unloaded = true
+			return true, nil
+		}
+		return loaded && unloaded, nil
+	})
+	backend.Handle("Page.navigate", func(params json.RawMessage) (interface{}, error) {
+		var p struct{ URL string }
+		if err := json.Unmarshal(params, &p); err != nil || p.URL != "https://example.com/" {
+			return nil, fmt.Errorf("unexpected params %s", params)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if !unloaded {
+			return nil, errors.New("navigated before marking the old document")
+		}
+		loaded = true
+		return map[string]string{"frameId": "main"}, nil
+	})
+
+	ft.run(func() { h.Navigate("https://example.com/") })
+	if ft.Failed() {
+		t.Fatalf("Navigate failed: %v", ft.errors)
+	}
+	last := backend.Calls()[len(backend.Calls())-1]
+	if !strings.Contains(string(last.Params), "__wv2testUnloaded === undefined") {
+		t.Fatalf("Navigate didn't wait for the new document, last call %s %s", last.Method, last.Params)
+	}
+}
+
+func TestNavigateErrorText(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	backend.HandleEval(func(string) (interface{}, error) { return true, nil })
+	backend.Handle("Page.navigate", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"frameId": "main", "errorText": "net::ERR_NAME_NOT_RESOLVED"}, nil
+	})
+	ft.wantFailure(t, func() { h.Navigate("https://invalid.example/") },
+		"navigating to https://invalid.example/", "net::ERR_NAME_NOT_RESOLVED")
+}
+
+func TestClick(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	backend.HandleEval(func(expression string) (interface{}, error) {
+		if strings.HasPrefix(expression, "!!(") {
+			if !strings.Contains(expression, `document.querySelector("#save")`) {
+				return nil, fmt.Errorf("unexpected expression %s", expression)
+			}
+			return true, nil
+		}
+		return map[string]float64{"x": 10.5, "y": 20}, nil
+	})
+	backend.Handle("Input.dispatchMouseEvent", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{}, nil
+	})
+
+	ft.run(func() { h.Click("#save") })
+	if ft.Failed() {
+		t.Fatalf("Click failed: %v", ft.errors)
+	}
+
+	var events []map[string]interface{}
+	for _, call := range backend.Calls() {
+		if call.Method != "Input.dispatchMouseEvent" {
+			continue
+		}
+		var params map[string]interface{}
+		if err := json.Unmarshal(call.Params, &params); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, params)
+	}
+	want := []map[string]interface{}{
+		{"type": "mouseMoved", "x": 10.5, "y": 20.0},
+		{"type": "mousePressed", "x": 10.5, "y": 20.0, "button": "left", "clickCount": 1.0},
+		{"type": "mouseReleased", "x": 10.5, "y": 20.0, "button": "left", "clickCount": 1.0},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("events = %v, want %v", events, want)
+	}
+}
+
+func TestClickMissingElement(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	backend.HandleEval(func(string) (interface{}, error) { return false, nil })
+	ft.wantFailure(t, func() { h.Click("#missing") }, "waiting for #missing")
+	for _, call := range backend.Calls() {
+		if call.Method == "Input.dispatchMouseEvent" {
+			t.Fatal("clicked a missing element")
+		}
+	}
+}
+
+// fakeBindingPage emulates the calls recorded by the wrapper of ExpectBinding.
+type fakeBindingPage struct {
+	mu      sync.Mutex
+	wrapped bool
+	calls   [][]interface{}
+}
+
+var (
+	callCountExpr = regexp.MustCompile(`^!!\(window\.__wv2test\.calls\["save"\]\.length > (\d+)\)$`)
+	callExpr      = regexp.MustCompile(`^window\.__wv2test\.calls\["save"\]\[(\d+)\]$`)
+)
+
+func (p *fakeBindingPage) eval(expression string) (interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if m := callCountExpr.FindStringSubmatch(expression); m != nil {
+		seen, _ := strconv.Atoi(m[1])
+		return len(p.calls) > seen, nil
+	}
+	if m := callExpr.FindStringSubmatch(expression); m != nil {
+		i, _ := strconv.Atoi(m[1])
+		if i >= len(p.calls) {
+			return nil, nil
+		}
+		return p.calls[i], nil
+	}
+	switch {
+	case strings.HasPrefix(expression, "!!(window.wv2 && window.wv2.bindings"):
+		return true, nil
+	case strings.Contains(expression, "state.calls[name] = []"):
+		p.wrapped = true
+		p.calls = nil
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unexpected expression %s", expression)
+}
+
+func (p *fakeBindingPage) call(args ...interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, args)
+}
+
+func TestExpectBinding(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	page := &fakeBindingPage{}
+	backend.HandleEval(page.eval)
+
+	var e *BindingExpectation
+	ft.run(func() { e = h.ExpectBinding("save") })
+	if ft.Failed() || !page.wrapped {
+		t.Fatalf("ExpectBinding failed: %v", ft.errors)
+	}
+
+	page.call("first", 1)
+	page.call("second", 2)
+
+	var first, second []json.RawMessage
+	ft.run(func() {
+		first = e.Wait()
+		second = e.Wait()
+	})
+	if ft.Failed() {
+		t.Fatalf("Wait failed: %v", ft.errors)
+	}
+	if got := fmt.Sprintf("%s", first); got != `["first" 1]` {
+		t.Fatalf("first call = %s", got)
+	}
+	if got := fmt.Sprintf("%s", second); got != `["second" 2]` {
+		t.Fatalf("second call = %s", got)
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		page.call("third", 3)
+	}()
+	var name string
+	var n int
+	ft.run(func() { e.WaitArgs(&name, &n) })
+	if ft.Failed() || name != "third" || n != 3 {
+		t.Fatalf("WaitArgs() = %q, %d, errors %v", name, n, ft.errors)
+	}
+
+	ft.wantFailure(t, func() { e.Wait() }, "waiting for a call of binding save")
+}
+
+func TestExpectBindingArgumentCount(t *testing.T) {
+	h, backend, ft := newTestHarness(t, fastOptions)
+	page := &fakeBindingPage{}
+	backend.HandleEval(page.eval)
+
+	var e *BindingExpectation
+	ft.run(func() { e = h.ExpectBinding("save") })
+	page.call("only")
+
+	var a, b string
+	ft.wantFailure(t, func() { e.WaitArgs(&a, &b) }, "called with 1 arguments, expected 2")
+}
+
+func TestArtifactsOnFailure(t *testing.T) {
+	png := []byte("\x89PNG fake")
+	dom := "https://example.com/\n<html><body>broken</body></html>"
+	dir := t.TempDir()
+
+	ft := newFakeT(t, "TestApp/save button")
+	backend := NewFakeBackend()
+	backend.HandleEval(func(expression string) (interface{}, error) {
+		if strings.Contains(expression, "outerHTML") {
+			return dom, nil
+		}
+		return nil, errors.New("Error: broken")
+	})
+	backend.Handle("Page.captureScreenshot", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"data": base64.StdEncoding.EncodeToString(png)}, nil
+	})
+
+	opts := fastOptions
+	opts.ArtifactDir = dir
+	h := New(ft, backend, opts)
+	ft.wantFailure(t, func() { h.Eval("save()") }, "Error: broken")
+	ft.finish()
+
+	artifacts := filepath.Join(dir, "TestApp_save_button")
+	if got, err := os.ReadFile(filepath.Join(artifacts, "screenshot.png")); err != nil || string(got) != string(png) {
+		t.Errorf("screenshot.png = %q, %v", got, err)
+	}
+	if got, err := os.ReadFile(filepath.Join(artifacts, "dom.html")); err != nil || string(got) != dom {
+		t.Errorf("dom.html = %q, %v", got, err)
+	}
+	if !backend.Closed() {
+		t.Error("the backend hasn't been closed")
+	}
+	if msg := strings.Join(ft.logs, "\n"); !strings.Contains(msg, artifacts) {
+		t.Errorf("logs %q don't mention %s", msg, artifacts)
+	}
+}
+
+func TestNoArtifactsOnSuccess(t *testing.T) {
+	dir := t.TempDir()
+	ft := newFakeT(t, "TestApp")
+	backend := NewFakeBackend()
+	backend.HandleEval(func(string) (interface{}, error) { return true, nil })
+
+	opts := fastOptions
+	opts.ArtifactDir = dir
+	h := New(ft, backend, opts)
+	ft.run(func() { h.Eval("true") })
+	ft.finish()
+
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) != 0 {
+		t.Errorf("artifacts = %v, %v, want none", entries, err)
+	}
+	if !backend.Closed() {
+		t.Error("the backend hasn't been closed")
+	}
+	for _, call := range backend.Calls() {
+		if call.Method == "Page.captureScreenshot" {
+			t.Error("took a screenshot of a passing test")
+		}
+	}
+}
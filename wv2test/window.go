@@ -0,0 +1,83 @@
+//go:build windows
+
+package wv2test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/b1naryth1ef/wv2"
+)
+
+// AssetsURL is the URL of the assets passed to NewWindow.
+const AssetsURL = "wv2test://app/"
+
+// NewWindow opens a window on its own UI thread and returns a harness for it, which closes the window when the test
+// finished. assets is served at AssetsURL if it isn't nil. The window navigates to opts.InitialURL, or AssetsURL if
+// it is empty, and NewWindow waits until the page has been loaded.
+func NewWindow(t testing.TB, opts wv2.WindowOpts, assets http.Handler, hopts Options) (*Harness, *wv2.Window) {
+	t.Helper()
+
+	initialURL := opts.InitialURL
+	if initialURL == "" {
+		initialURL = AssetsURL
+	}
+	// The harness navigates, so it can wait for the page.
+	opts.InitialURL = ""
+	if assets != nil {
+		opts.CustomSchemes = append(opts.CustomSchemes, wv2.CustomScheme{
+			Name:                  "wv2test",
+			TreatAsSecure:         true,
+			HasAuthorityComponent: true,
+			Handler:               assets,
+		})
+	}
+
+	created := make(chan *wv2.Window)
+	exited := make(chan struct{})
+	go func() {
+		// The window must be created and run on the same thread.
+		runtime.LockOSThread()
+		defer close(exited)
+
+		w := wv2.NewWindow(opts)
+		created <- w
+		w.Run()
+	}()
+
+	var w *wv2.Window
+	select {
+	case w = <-created:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("wv2test: creating the window timed out")
+	}
+
+	h := New(t, &windowBackend{window: w, exited: exited}, hopts)
+	h.Navigate(initialURL)
+	return h, w
+}
+
+// windowBackend calls the DevTools protocol of a window.
+type windowBackend struct {
+	window *wv2.Window
+	exited chan struct{}
+}
+
+func (b *windowBackend) CallDevToolsProtocolMethod(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	return b.window.CallDevToolsProtocolMethod(ctx, method, params)
+}
+
+func (b *windowBackend) Close() error {
+	b.window.Quit()
+	select {
+	case <-b.exited:
+		return nil
+	case <-time.After(10 * time.Second):
+		return errors.New("the window didn't close")
+	}
+}